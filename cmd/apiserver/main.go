@@ -1,5 +1,279 @@
+/*
+ * Copyright 2021 Aapeli.Smith<aapeli.nian@gmail.com>.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
 package main
 
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/controller"
+	"github.com/aapelismith/kun/pkg/apiserver/middleware"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/apiserver/server"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/auth"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/safe"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"net/http"
+	"os"
+	"sigs.k8s.io/yaml"
+	"time"
+)
+
+const (
+	// shutdownTimeout the maximum duration to wait for the servers to stop gracefully
+	shutdownTimeout = time.Second * 30
+
+	// handshakeTimeout the maximum duration to wait for the client hello of
+	// a connection to the https frontend
+	handshakeTimeout = time.Second * 10
+)
+
+type runnable interface {
+	Start(ctx context.Context) error
+	GracefulStop(ctx context.Context) error
+}
+
+// loadConfiguration load the configuration file given by --config, then
+// override it with the other command line parameters
+func loadConfiguration(args []string) (*config.Configuration, error) {
+	var configFile string
+
+	pre := pflag.NewFlagSet("apiserver", pflag.ContinueOnError)
+	pre.ParseErrorsWhitelist.UnknownFlags = true
+	pre.Usage = func() {}
+	pre.StringVar(&configFile, "config", "", "Path to the configuration file")
+	_ = pre.Parse(args)
+
+	cfg := config.NewConfiguration()
+	cfg.SetDefaults()
+
+	if configFile != "" {
+		data, err := os.ReadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("unable parse %s, got: %v", configFile, err)
+		}
+	}
+
+	fs := pflag.NewFlagSet("apiserver", pflag.ExitOnError)
+	fs.StringVar(&configFile, "config", configFile, "Path to the configuration file")
+	cfg.AddFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// newCertificateService create CertificateService serving the certificates
+// of the frontend certificate directory, then the ones obtained over ACME
+// if enabled, then the default certificate
+func newCertificateService(cfg *config.Configuration, acmeService *service.ACMEService) (*service.CertificateService, error) {
+	var store service.CertificateStore = service.NewMemoryCertificateStore()
+	if cfg.Frontend.CertificateDir != "" {
+		store = service.NewFileCertificateStore(cfg.Frontend.CertificateDir)
+	}
+
+	if cfg.Frontend.DefaultCertificateFile == "" {
+		return service.NewCertificateService(store, acmeService, nil), nil
+	}
+
+	fallback, err := tls.LoadX509KeyPair(cfg.Frontend.DefaultCertificateFile, cfg.Frontend.DefaultCertificateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return service.NewCertificateService(store, acmeService, &fallback), nil
+}
+
+func run(ctx context.Context, cfg *config.Configuration) error {
+	l := log.FromContext(ctx).Sugar()
+
+	plugin, err := auth.NewPlugin(cfg.Auth.Method)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = plugin.Close()
+	}()
+
+	if err := plugin.Setup(ctx, cfg.Auth.Options); err != nil {
+		return err
+	}
+
+	tokens, err := service.NewTokenService(cfg.Token)
+	if err != nil {
+		return err
+	}
+
+	var tcpPorts, udpPorts *service.PortAllocator
+	if cfg.Frontend.TCPPortMin != 0 {
+		tcpPorts = service.NewPortAllocator(cfg.Frontend.TCPBindHost, cfg.Frontend.TCPPortMin, cfg.Frontend.TCPPortMax)
+	}
+
+	if cfg.Frontend.UDPPortMin != 0 {
+		udpPorts = service.NewPortAllocator(cfg.Frontend.UDPBindHost, cfg.Frontend.UDPPortMin, cfg.Frontend.UDPPortMax)
+	}
+
+	upstreams := service.NewUpstreamService(tokens, tcpPorts, udpPorts, time.Duration(cfg.Backend.ResumeTimeout),
+		cfg.Frontend.BaseDomain)
+
+	// serve the public ports of the TCP and UDP upstreams as they are registered
+	proxy.NewTCPProxy(ctx, upstreams)
+	proxy.NewUDPProxy(ctx, upstreams, time.Duration(cfg.Frontend.UDPSessionTimeout))
+
+	var acmeService *service.ACMEService
+	if cfg.ACME.Enabled {
+		if acmeService, err = service.NewACMEService(cfg.ACME, upstreams); err != nil {
+			return err
+		}
+	}
+
+	certificates, err := newCertificateService(cfg, acmeService)
+	if err != nil {
+		return err
+	}
+
+	var domains *service.DomainService
+	if cfg.Domain.Enabled {
+		domains = service.NewDomainService(cfg.Domain, cfg.Frontend.BaseDomain)
+	}
+
+	limits := service.NewLimitService(cfg.Limit)
+
+	gates, err := service.NewGateService(cfg.Gate)
+	if err != nil {
+		return err
+	}
+
+	backend := controller.NewBackendController(plugin, tokens, upstreams, certificates, domains, limits, cfg.Tunnel)
+
+	authenticator := middleware.NewAuth(tokens,
+		"/"+v1.BackendController_ServiceDesc.ServiceName+"/Login",
+		"/"+v1.BackendController_ServiceDesc.ServiceName+"/ConnectTunnel",
+	)
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamServerInterceptor()),
+	)
+	v1.RegisterBackendControllerServer(grpcServer, backend)
+
+	// the gateway calls the controller through the interceptor of the grpc
+	// server, the REST routes are authenticated by the same session tokens
+	local := server.NewLocalConn(&v1.BackendController_ServiceDesc, backend, authenticator.UnaryServerInterceptor())
+
+	gateway := runtime.NewServeMux()
+	if err := v1.RegisterBackendControllerHandlerClient(ctx, gateway, v1.NewBackendControllerClient(local)); err != nil {
+		return err
+	}
+
+	backendServer, err := server.NewBackendServer(cfg, grpcServer, gateway)
+	if err != nil {
+		return err
+	}
+
+	trustedProxies, err := service.ParseCIDRs(cfg.Frontend.TrustedProxies)
+	if err != nil {
+		return err
+	}
+
+	httpProxy := proxy.NewHTTPProxy(upstreams, limits, gates, trustedProxies)
+
+	var frontendHandler http.Handler = httpProxy
+	if acmeService != nil {
+		// answer the HTTP-01 challenges on the http frontend
+		frontendHandler = acmeService.HTTPHandler(httpProxy)
+	}
+
+	defer func() {
+		_ = httpProxy.Close()
+	}()
+
+	servers := []runnable{backendServer}
+
+	if cfg.Frontend.HttpBindAddr != "" {
+		servers = append(servers, server.NewFrontendServer(ctx, cfg, frontendHandler))
+	}
+
+	if cfg.Frontend.HttpsBindAddr != "" {
+		secureFrontendServer, err := server.NewSecureFrontendServer(ctx, cfg, httpProxy,
+			certificates.GetCertificate, proxy.NewTLSProxy(upstreams, handshakeTimeout))
+		if err != nil {
+			return err
+		}
+		servers = append(servers, secureFrontendServer)
+	}
+
+	errCh := make(chan error, len(servers))
+
+	for _, s := range servers {
+		go func(s runnable) {
+			errCh <- s.Start(ctx)
+		}(s)
+	}
+
+	select {
+	case <-ctx.Done():
+	case err = <-errCh:
+	}
+
+	stopCtx, cancel := context.WithTimeout(log.NewContext(context.Background(), l.Desugar()), shutdownTimeout)
+	defer cancel()
+
+	for _, s := range servers {
+		_ = s.GracefulStop(stopCtx)
+	}
+	return err
+}
+
 func main() {
+	cfg, err := loadConfiguration(os.Args[1:])
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	ctx := safe.SetupSignalHandler()
+
+	logger, err := log.NewLogger(ctx, cfg.Log)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	zap.ReplaceGlobals(logger)
 
+	if err := run(log.NewContext(ctx, logger), cfg); err != nil {
+		logger.Fatal("apiserver exited", zap.Error(err))
+	}
 }
@@ -14,17 +14,92 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"github.com/aapelismith/kun/pkg/types"
-	"time"
+	"github.com/aapelismith/kun/pkg/client"
+	"github.com/aapelismith/kun/pkg/client/config"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/safe"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+	"os"
+	"sigs.k8s.io/yaml"
 )
 
-type hack struct {
-	Hello types.Duration `json:"hello"`
+// loadConfiguration load the configuration file given by --config, then
+// override it with the other command line parameters
+func loadConfiguration(args []string) (*config.Configuration, error) {
+	var configFile string
+
+	pre := pflag.NewFlagSet("client", pflag.ContinueOnError)
+	pre.ParseErrorsWhitelist.UnknownFlags = true
+	pre.Usage = func() {}
+	pre.StringVar(&configFile, "config", "", "Path to the configuration file")
+	_ = pre.Parse(args)
+
+	cfg := config.NewConfiguration()
+	cfg.SetDefaults()
+
+	if configFile != "" {
+		data, err := os.ReadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("unable parse %s, got: %v", configFile, err)
+		}
+	}
+
+	fs := pflag.NewFlagSet("client", pflag.ExitOnError)
+	fs.StringVar(&configFile, "config", configFile, "Path to the configuration file")
+	cfg.AddFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func run(ctx context.Context, cfg *config.Configuration) error {
+	c, err := client.NewClient(cfg.Client, cfg.HealthCheck, cfg.Tunnel)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = c.Close()
+	}()
+
+	return c.Run(ctx)
 }
 
 func main() {
-	a := types.Duration(time.Second * 3)
+	cfg, err := loadConfiguration(os.Args[1:])
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	ctx := safe.SetupSignalHandler()
+
+	logger, err := log.NewLogger(ctx, cfg.Log)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	zap.ReplaceGlobals(logger)
 
-	fmt.Printf("%s", a.String())
+	if err := run(log.NewContext(ctx, logger), cfg); err != nil {
+		logger.Fatal("client exited", zap.Error(err))
+	}
 }
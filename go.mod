@@ -4,10 +4,16 @@ go 1.18
 
 require (
 	github.com/envoyproxy/protoc-gen-validate v0.9.0
+	github.com/golang-jwt/jwt/v4 v4.4.3
+	github.com/google/uuid v1.1.2
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.14.0
+	github.com/klauspost/compress v1.15.12
 	github.com/spf13/pflag v1.0.5
 	go.uber.org/zap v1.23.0
+	golang.org/x/crypto v0.2.0
 	golang.org/x/exp v0.0.0-20220827204233-334a2380cb91
+	golang.org/x/net v0.2.0
+	golang.org/x/time v0.2.0
 	google.golang.org/genproto v0.0.0-20221114212237-e4508ebdbee1
 	google.golang.org/grpc v1.50.1
 	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.2.0
@@ -26,7 +32,6 @@ require (
 	go.uber.org/multierr v1.6.0 // indirect
 	golang.org/x/lint v0.0.0-20210508222113-6edffad5e616 // indirect
 	golang.org/x/mod v0.6.0 // indirect
-	golang.org/x/net v0.2.0 // indirect
 	golang.org/x/sys v0.2.0 // indirect
 	golang.org/x/text v0.4.0 // indirect
 	golang.org/x/tools v0.2.0 // indirect
@@ -24,6 +24,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -186,33 +187,1041 @@ func (x *LoginResponse) GetExpiredAt() string {
 	return ""
 }
 
+type HeaderRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action  string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value   string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *HeaderRule) Reset() {
+	*x = HeaderRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderRule) ProtoMessage() {}
+
+func (x *HeaderRule) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderRule.ProtoReflect.Descriptor instead.
+func (*HeaderRule) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HeaderRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *HeaderRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HeaderRule) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *HeaderRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
 type WatchTunnelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	PoolSize int32  `protobuf:"varint,3,opt,name=poolSize,proto3" json:"poolSize,omitempty"`
+	Hostname        string        `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Protocol        string        `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	PoolSize        int32         `protobuf:"varint,3,opt,name=poolSize,proto3" json:"poolSize,omitempty"`
+	Port            int32         `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Http2           bool          `protobuf:"varint,5,opt,name=http2,proto3" json:"http2,omitempty"`
+	Multiplex       bool          `protobuf:"varint,6,opt,name=multiplex,proto3" json:"multiplex,omitempty"`
+	Compression     []string      `protobuf:"bytes,7,rep,name=compression,proto3" json:"compression,omitempty"`
+	ResumeId        string        `protobuf:"bytes,8,opt,name=resumeId,proto3" json:"resumeId,omitempty"`
+	MinPoolSize     int32         `protobuf:"varint,9,opt,name=minPoolSize,proto3" json:"minPoolSize,omitempty"`
+	MaxPoolSize     int32         `protobuf:"varint,10,opt,name=maxPoolSize,proto3" json:"maxPoolSize,omitempty"`
+	LoadBalancing   string        `protobuf:"bytes,11,opt,name=loadBalancing,proto3" json:"loadBalancing,omitempty"`
+	Weight          int32         `protobuf:"varint,12,opt,name=weight,proto3" json:"weight,omitempty"`
+	PathPrefix      string        `protobuf:"bytes,13,opt,name=pathPrefix,proto3" json:"pathPrefix,omitempty"`
+	StripPrefix     bool          `protobuf:"varint,14,opt,name=stripPrefix,proto3" json:"stripPrefix,omitempty"`
+	RewritePrefix   string        `protobuf:"bytes,15,opt,name=rewritePrefix,proto3" json:"rewritePrefix,omitempty"`
+	RequestHeaders  []*HeaderRule `protobuf:"bytes,16,rep,name=requestHeaders,proto3" json:"requestHeaders,omitempty"`
+	ResponseHeaders []*HeaderRule `protobuf:"bytes,17,rep,name=responseHeaders,proto3" json:"responseHeaders,omitempty"`
+	AllowCidrs      []string      `protobuf:"bytes,18,rep,name=allowCidrs,proto3" json:"allowCidrs,omitempty"`
+	DenyCidrs       []string      `protobuf:"bytes,19,rep,name=denyCidrs,proto3" json:"denyCidrs,omitempty"`
+	BasicAuth       []string      `protobuf:"bytes,20,rep,name=basicAuth,proto3" json:"basicAuth,omitempty"`
+	BearerTokens    []string      `protobuf:"bytes,21,rep,name=bearerTokens,proto3" json:"bearerTokens,omitempty"`
+	WindowSize      int32         `protobuf:"varint,22,opt,name=windowSize,proto3" json:"windowSize,omitempty"`
+}
+
+func (x *WatchTunnelsRequest) Reset() {
+	*x = WatchTunnelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTunnelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTunnelsRequest) ProtoMessage() {}
+
+func (x *WatchTunnelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTunnelsRequest.ProtoReflect.Descriptor instead.
+func (*WatchTunnelsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchTunnelsRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *WatchTunnelsRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *WatchTunnelsRequest) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *WatchTunnelsRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WatchTunnelsRequest) GetHttp2() bool {
+	if x != nil {
+		return x.Http2
+	}
+	return false
+}
+
+func (x *WatchTunnelsRequest) GetMultiplex() bool {
+	if x != nil {
+		return x.Multiplex
+	}
+	return false
+}
+
+func (x *WatchTunnelsRequest) GetCompression() []string {
+	if x != nil {
+		return x.Compression
+	}
+	return nil
+}
+
+func (x *WatchTunnelsRequest) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+func (x *WatchTunnelsRequest) GetMinPoolSize() int32 {
+	if x != nil {
+		return x.MinPoolSize
+	}
+	return 0
+}
+
+func (x *WatchTunnelsRequest) GetMaxPoolSize() int32 {
+	if x != nil {
+		return x.MaxPoolSize
+	}
+	return 0
+}
+
+func (x *WatchTunnelsRequest) GetLoadBalancing() string {
+	if x != nil {
+		return x.LoadBalancing
+	}
+	return ""
+}
+
+func (x *WatchTunnelsRequest) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *WatchTunnelsRequest) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *WatchTunnelsRequest) GetStripPrefix() bool {
+	if x != nil {
+		return x.StripPrefix
+	}
+	return false
+}
+
+func (x *WatchTunnelsRequest) GetRewritePrefix() string {
+	if x != nil {
+		return x.RewritePrefix
+	}
+	return ""
+}
+
+func (x *WatchTunnelsRequest) GetRequestHeaders() []*HeaderRule {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
+}
+
+func (x *WatchTunnelsRequest) GetResponseHeaders() []*HeaderRule {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *WatchTunnelsRequest) GetAllowCidrs() []string {
+	if x != nil {
+		return x.AllowCidrs
+	}
+	return nil
+}
+
+func (x *WatchTunnelsRequest) GetDenyCidrs() []string {
+	if x != nil {
+		return x.DenyCidrs
+	}
+	return nil
+}
+
+func (x *WatchTunnelsRequest) GetBasicAuth() []string {
+	if x != nil {
+		return x.BasicAuth
+	}
+	return nil
+}
+
+func (x *WatchTunnelsRequest) GetBearerTokens() []string {
+	if x != nil {
+		return x.BearerTokens
+	}
+	return nil
+}
+
+func (x *WatchTunnelsRequest) GetWindowSize() int32 {
+	if x != nil {
+		return x.WindowSize
+	}
+	return 0
+}
+
+type WatchTunnelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId     string `protobuf:"bytes,1,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	TunnelToken string `protobuf:"bytes,2,opt,name=tunnelToken,proto3" json:"tunnelToken,omitempty"`
+	Port        int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Compression string `protobuf:"bytes,4,opt,name=compression,proto3" json:"compression,omitempty"`
+	UpstreamId  string `protobuf:"bytes,5,opt,name=upstreamId,proto3" json:"upstreamId,omitempty"`
+	Hostname    string `protobuf:"bytes,6,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	WindowSize  int32  `protobuf:"varint,7,opt,name=windowSize,proto3" json:"windowSize,omitempty"`
+}
+
+func (x *WatchTunnelsResponse) Reset() {
+	*x = WatchTunnelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTunnelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTunnelsResponse) ProtoMessage() {}
+
+func (x *WatchTunnelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTunnelsResponse.ProtoReflect.Descriptor instead.
+func (*WatchTunnelsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchTunnelsResponse) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *WatchTunnelsResponse) GetTunnelToken() string {
+	if x != nil {
+		return x.TunnelToken
+	}
+	return ""
+}
+
+func (x *WatchTunnelsResponse) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WatchTunnelsResponse) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *WatchTunnelsResponse) GetUpstreamId() string {
+	if x != nil {
+		return x.UpstreamId
+	}
+	return ""
+}
+
+func (x *WatchTunnelsResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *WatchTunnelsResponse) GetWindowSize() int32 {
+	if x != nil {
+		return x.WindowSize
+	}
+	return 0
+}
+
+type GetUpstreamStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname   string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	UpstreamId string `protobuf:"bytes,2,opt,name=upstreamId,proto3" json:"upstreamId,omitempty"`
+}
+
+func (x *GetUpstreamStatsRequest) Reset() {
+	*x = GetUpstreamStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUpstreamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpstreamStatsRequest) ProtoMessage() {}
+
+func (x *GetUpstreamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpstreamStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUpstreamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUpstreamStatsRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *GetUpstreamStatsRequest) GetUpstreamId() string {
+	if x != nil {
+		return x.UpstreamId
+	}
+	return ""
+}
+
+type GetUpstreamStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpstreamId     string               `protobuf:"bytes,1,opt,name=upstreamId,proto3" json:"upstreamId,omitempty"`
+	Hostname       string               `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	PoolSize       int32                `protobuf:"varint,3,opt,name=poolSize,proto3" json:"poolSize,omitempty"`
+	MinPoolSize    int32                `protobuf:"varint,4,opt,name=minPoolSize,proto3" json:"minPoolSize,omitempty"`
+	MaxPoolSize    int32                `protobuf:"varint,5,opt,name=maxPoolSize,proto3" json:"maxPoolSize,omitempty"`
+	Idle           int32                `protobuf:"varint,6,opt,name=idle,proto3" json:"idle,omitempty"`
+	Active         int32                `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	Pending        int32                `protobuf:"varint,8,opt,name=pending,proto3" json:"pending,omitempty"`
+	Waiting        int32                `protobuf:"varint,9,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	Sessions       int32                `protobuf:"varint,10,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	PeakActive     int32                `protobuf:"varint,11,opt,name=peakActive,proto3" json:"peakActive,omitempty"`
+	Acquired       uint64               `protobuf:"varint,12,opt,name=acquired,proto3" json:"acquired,omitempty"`
+	ScaleUps       uint64               `protobuf:"varint,13,opt,name=scaleUps,proto3" json:"scaleUps,omitempty"`
+	ScaleDowns     uint64               `protobuf:"varint,14,opt,name=scaleDowns,proto3" json:"scaleDowns,omitempty"`
+	AcquireWaitAvg *durationpb.Duration `protobuf:"bytes,15,opt,name=acquireWaitAvg,proto3" json:"acquireWaitAvg,omitempty"`
+	AcquireWaitMax *durationpb.Duration `protobuf:"bytes,16,opt,name=acquireWaitMax,proto3" json:"acquireWaitMax,omitempty"`
+	LoadBalancing  string               `protobuf:"bytes,17,opt,name=loadBalancing,proto3" json:"loadBalancing,omitempty"`
+	Weight         int32                `protobuf:"varint,18,opt,name=weight,proto3" json:"weight,omitempty"`
+	UpstreamIds    []string             `protobuf:"bytes,19,rep,name=upstreamIds,proto3" json:"upstreamIds,omitempty"`
+	PathPrefix     string               `protobuf:"bytes,20,opt,name=pathPrefix,proto3" json:"pathPrefix,omitempty"`
+}
+
+func (x *GetUpstreamStatsResponse) Reset() {
+	*x = GetUpstreamStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUpstreamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpstreamStatsResponse) ProtoMessage() {}
+
+func (x *GetUpstreamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpstreamStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetUpstreamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUpstreamStatsResponse) GetUpstreamId() string {
+	if x != nil {
+		return x.UpstreamId
+	}
+	return ""
+}
+
+func (x *GetUpstreamStatsResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *GetUpstreamStatsResponse) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetMinPoolSize() int32 {
+	if x != nil {
+		return x.MinPoolSize
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetMaxPoolSize() int32 {
+	if x != nil {
+		return x.MaxPoolSize
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetWaiting() int32 {
+	if x != nil {
+		return x.Waiting
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetPeakActive() int32 {
+	if x != nil {
+		return x.PeakActive
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetAcquired() uint64 {
+	if x != nil {
+		return x.Acquired
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetScaleUps() uint64 {
+	if x != nil {
+		return x.ScaleUps
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetScaleDowns() uint64 {
+	if x != nil {
+		return x.ScaleDowns
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetAcquireWaitAvg() *durationpb.Duration {
+	if x != nil {
+		return x.AcquireWaitAvg
+	}
+	return nil
+}
+
+func (x *GetUpstreamStatsResponse) GetAcquireWaitMax() *durationpb.Duration {
+	if x != nil {
+		return x.AcquireWaitMax
+	}
+	return nil
+}
+
+func (x *GetUpstreamStatsResponse) GetLoadBalancing() string {
+	if x != nil {
+		return x.LoadBalancing
+	}
+	return ""
+}
+
+func (x *GetUpstreamStatsResponse) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *GetUpstreamStatsResponse) GetUpstreamIds() []string {
+	if x != nil {
+		return x.UpstreamIds
+	}
+	return nil
+}
+
+func (x *GetUpstreamStatsResponse) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+type ReportHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname   string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	UpstreamId string `protobuf:"bytes,2,opt,name=upstreamId,proto3" json:"upstreamId,omitempty"`
+	Healthy    bool   `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReportHealthRequest) Reset() {
+	*x = ReportHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportHealthRequest) ProtoMessage() {}
+
+func (x *ReportHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportHealthRequest.ProtoReflect.Descriptor instead.
+func (*ReportHealthRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReportHealthRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ReportHealthRequest) GetUpstreamId() string {
+	if x != nil {
+		return x.UpstreamId
+	}
+	return ""
+}
+
+func (x *ReportHealthRequest) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ReportHealthRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ReportHealthResponse) Reset() {
+	*x = ReportHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportHealthResponse) ProtoMessage() {}
+
+func (x *ReportHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportHealthResponse.ProtoReflect.Descriptor instead.
+func (*ReportHealthResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReportHealthResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type TunnelMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command  string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Payload  []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	StreamId uint32 `protobuf:"varint,3,opt,name=streamId,proto3" json:"streamId,omitempty"`
+	Window   uint32 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
+}
+
+func (x *TunnelMessage) Reset() {
+	*x = TunnelMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelMessage) ProtoMessage() {}
+
+func (x *TunnelMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelMessage.ProtoReflect.Descriptor instead.
+func (*TunnelMessage) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TunnelMessage) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *TunnelMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TunnelMessage) GetStreamId() uint32 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *TunnelMessage) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *TunnelMessage) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+type UploadCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname    string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Certificate string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	PrivateKey  string `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+}
+
+func (x *UploadCertificateRequest) Reset() {
+	*x = UploadCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCertificateRequest) ProtoMessage() {}
+
+func (x *UploadCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCertificateRequest.ProtoReflect.Descriptor instead.
+func (*UploadCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UploadCertificateRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *UploadCertificateRequest) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *UploadCertificateRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+type UploadCertificateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname  string   `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	DnsNames  []string `protobuf:"bytes,2,rep,name=dnsNames,proto3" json:"dnsNames,omitempty"`
+	ExpiredAt string   `protobuf:"bytes,3,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
+}
+
+func (x *UploadCertificateResponse) Reset() {
+	*x = UploadCertificateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCertificateResponse) ProtoMessage() {}
+
+func (x *UploadCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCertificateResponse.ProtoReflect.Descriptor instead.
+func (*UploadCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UploadCertificateResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *UploadCertificateResponse) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+func (x *UploadCertificateResponse) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+type ClaimDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+}
+
+func (x *ClaimDomainRequest) Reset() {
+	*x = ClaimDomainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimDomainRequest) ProtoMessage() {}
+
+func (x *ClaimDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimDomainRequest.ProtoReflect.Descriptor instead.
+func (*ClaimDomainRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClaimDomainRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+type ClaimDomainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname    string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Status      string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	TxtName     string `protobuf:"bytes,3,opt,name=txtName,proto3" json:"txtName,omitempty"`
+	TxtValue    string `protobuf:"bytes,4,opt,name=txtValue,proto3" json:"txtValue,omitempty"`
+	CnameTarget string `protobuf:"bytes,5,opt,name=cnameTarget,proto3" json:"cnameTarget,omitempty"`
+	ExpiredAt   string `protobuf:"bytes,6,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
 }
 
-func (x *WatchTunnelsRequest) Reset() {
-	*x = WatchTunnelsRequest{}
+func (x *ClaimDomainResponse) Reset() {
+	*x = ClaimDomainResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tunnel_proto_msgTypes[2]
+		mi := &file_tunnel_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WatchTunnelsRequest) String() string {
+func (x *ClaimDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchTunnelsRequest) ProtoMessage() {}
+func (*ClaimDomainResponse) ProtoMessage() {}
 
-func (x *WatchTunnelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tunnel_proto_msgTypes[2]
+func (x *ClaimDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -223,58 +1232,78 @@ func (x *WatchTunnelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchTunnelsRequest.ProtoReflect.Descriptor instead.
-func (*WatchTunnelsRequest) Descriptor() ([]byte, []int) {
-	return file_tunnel_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ClaimDomainResponse.ProtoReflect.Descriptor instead.
+func (*ClaimDomainResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *WatchTunnelsRequest) GetHostname() string {
+func (x *ClaimDomainResponse) GetHostname() string {
 	if x != nil {
 		return x.Hostname
 	}
 	return ""
 }
 
-func (x *WatchTunnelsRequest) GetProtocol() string {
+func (x *ClaimDomainResponse) GetStatus() string {
 	if x != nil {
-		return x.Protocol
+		return x.Status
 	}
 	return ""
 }
 
-func (x *WatchTunnelsRequest) GetPoolSize() int32 {
+func (x *ClaimDomainResponse) GetTxtName() string {
 	if x != nil {
-		return x.PoolSize
+		return x.TxtName
 	}
-	return 0
+	return ""
 }
 
-type WatchTunnelsResponse struct {
+func (x *ClaimDomainResponse) GetTxtValue() string {
+	if x != nil {
+		return x.TxtValue
+	}
+	return ""
+}
+
+func (x *ClaimDomainResponse) GetCnameTarget() string {
+	if x != nil {
+		return x.CnameTarget
+	}
+	return ""
+}
+
+func (x *ClaimDomainResponse) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+type VerifyDomainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TraceId     string `protobuf:"bytes,1,opt,name=traceId,proto3" json:"traceId,omitempty"`
-	TunnelToken string `protobuf:"bytes,2,opt,name=tunnelToken,proto3" json:"tunnelToken,omitempty"`
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
 }
 
-func (x *WatchTunnelsResponse) Reset() {
-	*x = WatchTunnelsResponse{}
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tunnel_proto_msgTypes[3]
+		mi := &file_tunnel_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WatchTunnelsResponse) String() string {
+func (x *VerifyDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchTunnelsResponse) ProtoMessage() {}
+func (*VerifyDomainRequest) ProtoMessage() {}
 
-func (x *WatchTunnelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tunnel_proto_msgTypes[3]
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,51 +1314,46 @@ func (x *WatchTunnelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchTunnelsResponse.ProtoReflect.Descriptor instead.
-func (*WatchTunnelsResponse) Descriptor() ([]byte, []int) {
-	return file_tunnel_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *WatchTunnelsResponse) GetTraceId() string {
-	if x != nil {
-		return x.TraceId
-	}
-	return ""
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WatchTunnelsResponse) GetTunnelToken() string {
+func (x *VerifyDomainRequest) GetHostname() string {
 	if x != nil {
-		return x.TunnelToken
+		return x.Hostname
 	}
 	return ""
 }
 
-type TunnelMessage struct {
+type VerifyDomainResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
-	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Hostname   string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Status     string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Method     string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	VerifiedAt string `protobuf:"bytes,4,opt,name=verifiedAt,proto3" json:"verifiedAt,omitempty"`
 }
 
-func (x *TunnelMessage) Reset() {
-	*x = TunnelMessage{}
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tunnel_proto_msgTypes[4]
+		mi := &file_tunnel_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelMessage) String() string {
+func (x *VerifyDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelMessage) ProtoMessage() {}
+func (*VerifyDomainResponse) ProtoMessage() {}
 
-func (x *TunnelMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_tunnel_proto_msgTypes[4]
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,23 +1364,37 @@ func (x *TunnelMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelMessage.ProtoReflect.Descriptor instead.
-func (*TunnelMessage) Descriptor() ([]byte, []int) {
-	return file_tunnel_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TunnelMessage) GetCommand() string {
+func (x *VerifyDomainResponse) GetHostname() string {
 	if x != nil {
-		return x.Command
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *TunnelMessage) GetPayload() []byte {
+func (x *VerifyDomainResponse) GetStatus() string {
 	if x != nil {
-		return x.Payload
+		return x.Status
 	}
-	return nil
+	return ""
+}
+
+func (x *VerifyDomainResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *VerifyDomainResponse) GetVerifiedAt() string {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return ""
 }
 
 type WatchUpstreamsRequest struct {
@@ -370,7 +1408,7 @@ type WatchUpstreamsRequest struct {
 func (x *WatchUpstreamsRequest) Reset() {
 	*x = WatchUpstreamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tunnel_proto_msgTypes[5]
+		mi := &file_tunnel_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -383,7 +1421,7 @@ func (x *WatchUpstreamsRequest) String() string {
 func (*WatchUpstreamsRequest) ProtoMessage() {}
 
 func (x *WatchUpstreamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tunnel_proto_msgTypes[5]
+	mi := &file_tunnel_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +1434,7 @@ func (x *WatchUpstreamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchUpstreamsRequest.ProtoReflect.Descriptor instead.
 func (*WatchUpstreamsRequest) Descriptor() ([]byte, []int) {
-	return file_tunnel_proto_rawDescGZIP(), []int{5}
+	return file_tunnel_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *WatchUpstreamsRequest) GetStartedAt() *timestamppb.Timestamp {
@@ -424,7 +1462,7 @@ type WatchUpstreamsResponse struct {
 func (x *WatchUpstreamsResponse) Reset() {
 	*x = WatchUpstreamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tunnel_proto_msgTypes[6]
+		mi := &file_tunnel_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -437,7 +1475,7 @@ func (x *WatchUpstreamsResponse) String() string {
 func (*WatchUpstreamsResponse) ProtoMessage() {}
 
 func (x *WatchUpstreamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tunnel_proto_msgTypes[6]
+	mi := &file_tunnel_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +1488,7 @@ func (x *WatchUpstreamsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchUpstreamsResponse.ProtoReflect.Descriptor instead.
 func (*WatchUpstreamsResponse) Descriptor() ([]byte, []int) {
-	return file_tunnel_proto_rawDescGZIP(), []int{6}
+	return file_tunnel_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WatchUpstreamsResponse) GetEventType() string {
@@ -521,7 +1559,7 @@ type ConnectUpstreamRequest struct {
 func (x *ConnectUpstreamRequest) Reset() {
 	*x = ConnectUpstreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tunnel_proto_msgTypes[7]
+		mi := &file_tunnel_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -534,7 +1572,7 @@ func (x *ConnectUpstreamRequest) String() string {
 func (*ConnectUpstreamRequest) ProtoMessage() {}
 
 func (x *ConnectUpstreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tunnel_proto_msgTypes[7]
+	mi := &file_tunnel_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +1585,7 @@ func (x *ConnectUpstreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectUpstreamRequest.ProtoReflect.Descriptor instead.
 func (*ConnectUpstreamRequest) Descriptor() ([]byte, []int) {
-	return file_tunnel_proto_rawDescGZIP(), []int{7}
+	return file_tunnel_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ConnectUpstreamRequest) GetCommand() string {
@@ -576,7 +1614,7 @@ type ConnectUpstreamResponse struct {
 func (x *ConnectUpstreamResponse) Reset() {
 	*x = ConnectUpstreamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tunnel_proto_msgTypes[8]
+		mi := &file_tunnel_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +1627,7 @@ func (x *ConnectUpstreamResponse) String() string {
 func (*ConnectUpstreamResponse) ProtoMessage() {}
 
 func (x *ConnectUpstreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tunnel_proto_msgTypes[8]
+	mi := &file_tunnel_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +1640,7 @@ func (x *ConnectUpstreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectUpstreamResponse.ProtoReflect.Descriptor instead.
 func (*ConnectUpstreamResponse) Descriptor() ([]byte, []int) {
-	return file_tunnel_proto_rawDescGZIP(), []int{8}
+	return file_tunnel_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ConnectUpstreamResponse) GetCommand() string {
@@ -625,7 +1663,9 @@ var file_tunnel_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
 	0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
 	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
@@ -685,244 +1725,1162 @@ var file_tunnel_proto_rawDesc = []byte{
 	0x28, 0x09, 0x42, 0x2c, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x92, 0x41, 0x22, 0x32, 0x20,
 	0x54, 0x68, 0x65, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74,
 	0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbf, 0x02, 0x0a, 0x13,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xfa, 0x42, 0x09, 0x72, 0x07, 0x10, 0x01, 0x18, 0xfd,
-	0x01, 0x68, 0x01, 0x92, 0x41, 0x2b, 0x32, 0x16, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4a, 0x11,
-	0x22, 0x77, 0x77, 0x77, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d,
-	0x22, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x72, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0xfa,
-	0x42, 0x0f, 0x72, 0x0d, 0x52, 0x04, 0x48, 0x54, 0x54, 0x50, 0x52, 0x05, 0x48, 0x54, 0x54, 0x50,
-	0x53, 0x92, 0x41, 0x41, 0x32, 0x28, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x20, 0x75,
-	0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x06,
-	0x22, 0x48, 0x54, 0x54, 0x50, 0x22, 0xf2, 0x02, 0x04, 0x48, 0x54, 0x54, 0x50, 0xf2, 0x02, 0x05,
-	0x48, 0x54, 0x54, 0x50, 0x53, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
-	0x5c, 0x0a, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x40, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x92, 0x41, 0x36, 0x32, 0x30, 0x54,
-	0x68, 0x65, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x4a,
-	0x02, 0x31, 0x32, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xbe, 0x02,
-	0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x58, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01,
-	0x01, 0x92, 0x41, 0x4d, 0x32, 0x23, 0x54, 0x68, 0x65, 0x20, 0x69, 0x64, 0x20, 0x6f, 0x66, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x6c, 0x69, 0x6e, 0x6b,
-	0x20, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4a, 0x26, 0x22, 0x30, 0x32, 0x37, 0x38,
-	0x33, 0x33, 0x43, 0x30, 0x2d, 0x34, 0x34, 0x34, 0x35, 0x2d, 0x34, 0x45, 0x30, 0x33, 0x2d, 0x38,
-	0x42, 0x31, 0x37, 0x2d, 0x45, 0x42, 0x44, 0x42, 0x33, 0x43, 0x38, 0x44, 0x34, 0x46, 0x33, 0x41,
-	0x22, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0xb1, 0x01, 0x0a, 0x0b, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x8e, 0x01, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x92, 0x41, 0x83, 0x01, 0x32, 0x22,
-	0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x57, 0x65, 0x62, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66,
-	0x6f, 0x72, 0x20, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x57, 0x61, 0x74, 0x63, 0x68, 0x69,
-	0x6e, 0x67, 0x4a, 0x5d, 0x22, 0x65, 0x79, 0x4a, 0x68, 0x62, 0x47, 0x63, 0x69, 0x4f, 0x69, 0x4a,
-	0x49, 0x55, 0x7a, 0x49, 0x31, 0x4e, 0x69, 0x49, 0x73, 0x49, 0x6e, 0x52, 0x35, 0x63, 0x43, 0x49,
-	0x36, 0x49, 0x6b, 0x70, 0x58, 0x56, 0x43, 0x4a, 0x39, 0x2e, 0x65, 0x79, 0x4a, 0x68, 0x49, 0x6a,
-	0x6f, 0x78, 0x66, 0x51, 0x2e, 0x5a, 0x34, 0x72, 0x47, 0x4b, 0x2d, 0x76, 0x36, 0x61, 0x32, 0x73,
-	0x57, 0x41, 0x55, 0x51, 0x64, 0x6d, 0x41, 0x4c, 0x52, 0x33, 0x61, 0x59, 0x62, 0x58, 0x5a, 0x76,
-	0x69, 0x4c, 0x72, 0x38, 0x6a, 0x32, 0x36, 0x61, 0x39, 0x6e, 0x64, 0x78, 0x5f, 0x62, 0x4d, 0x34,
-	0x22, 0x52, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8d,
-	0x02, 0x0a, 0x0d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x98, 0x01, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x7e, 0xfa, 0x42, 0x23, 0x72, 0x21, 0x52, 0x04, 0x50, 0x49, 0x4e, 0x47, 0x52,
-	0x04, 0x50, 0x4f, 0x4e, 0x47, 0x52, 0x04, 0x50, 0x55, 0x53, 0x48, 0x52, 0x06, 0x46, 0x49, 0x4e,
-	0x49, 0x53, 0x48, 0x52, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x92, 0x41, 0x55, 0x32, 0x25, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x06, 0x22, 0x50, 0x49, 0x4e, 0x47, 0x22, 0xf2, 0x02, 0x04, 0x50,
-	0x49, 0x4e, 0x47, 0xf2, 0x02, 0x04, 0x50, 0x4f, 0x4e, 0x47, 0xf2, 0x02, 0x04, 0x50, 0x55, 0x53,
-	0x48, 0xf2, 0x02, 0x06, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0xf2, 0x02, 0x05, 0x52, 0x45, 0x53,
-	0x45, 0x54, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x61, 0x0a, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x47, 0x92, 0x41,
-	0x44, 0x32, 0x1a, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x6f,
-	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x26, 0x22,
-	0x30, 0x45, 0x35, 0x39, 0x39, 0x30, 0x38, 0x36, 0x2d, 0x38, 0x33, 0x30, 0x31, 0x2d, 0x34, 0x38,
-	0x42, 0x30, 0x2d, 0x38, 0x37, 0x30, 0x33, 0x2d, 0x34, 0x44, 0x31, 0x42, 0x36, 0x46, 0x32, 0x32,
-	0x46, 0x32, 0x39, 0x35, 0x22, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x94,
-	0x01, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x24, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x64, 0x61, 0x74, 0x61, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x64, 0x4a, 0x16, 0x22, 0x31, 0x39, 0x37, 0x30, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54,
-	0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa7, 0x07, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55,
-	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x68, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x4a, 0xfa, 0x42, 0x1c, 0x72, 0x1a, 0x52, 0x05, 0x41, 0x44, 0x44, 0x45,
-	0x44, 0x52, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x52, 0x07, 0x44, 0x45, 0x4c,
-	0x45, 0x54, 0x45, 0x44, 0x92, 0x41, 0x28, 0x32, 0x1d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x74,
-	0x79, 0x70, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x07, 0x22, 0x41, 0x44, 0x44, 0x45, 0x44, 0x22, 0x52,
-	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x67, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x57, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
-	0x92, 0x41, 0x4c, 0x32, 0x22, 0x54, 0x68, 0x65, 0x20, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x6c,
-	0x79, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x49, 0x44, 0x20, 0x6f, 0x66, 0x20, 0x75,
-	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x26, 0x22, 0x34, 0x38, 0x31, 0x65, 0x33, 0x63,
-	0x39, 0x37, 0x2d, 0x36, 0x33, 0x38, 0x63, 0x2d, 0x34, 0x62, 0x38, 0x66, 0x2d, 0x62, 0x35, 0x66,
-	0x35, 0x2d, 0x34, 0x39, 0x62, 0x61, 0x61, 0x32, 0x33, 0x62, 0x64, 0x30, 0x63, 0x39, 0x22, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x70, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x54, 0xfa, 0x42, 0x0f, 0x72, 0x0d, 0x52, 0x04, 0x48, 0x54,
-	0x54, 0x50, 0x52, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x92, 0x41, 0x3f, 0x32, 0x26, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x4a, 0x06, 0x22, 0x48, 0x54, 0x54, 0x50, 0x22, 0xf2, 0x02, 0x04, 0x48,
-	0x54, 0x54, 0x50, 0xf2, 0x02, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x61, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xfa, 0x42, 0x09, 0x72, 0x07, 0x10, 0x01,
-	0x18, 0xfd, 0x01, 0x68, 0x01, 0x92, 0x41, 0x36, 0x32, 0x21, 0x48, 0x6f, 0x73, 0x74, 0x20, 0x6e,
-	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x11, 0x22, 0x77, 0x77,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb0, 0x06, 0x0a, 0x0a,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0xe0, 0x02, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xc7, 0x02, 0xfa, 0x42,
+	0x1d, 0x72, 0x1b, 0x52, 0x03, 0x41, 0x44, 0x44, 0x52, 0x03, 0x53, 0x45, 0x54, 0x52, 0x06, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x52, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x92, 0x41,
+	0xa3, 0x02, 0x32, 0xfa, 0x01, 0x41, 0x44, 0x44, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x73,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2c, 0x20, 0x53, 0x45, 0x54, 0x20, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20,
+	0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x52, 0x45, 0x50, 0x4c,
+	0x41, 0x43, 0x45, 0x20, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68,
+	0x20, 0x6d, 0x61, 0x79, 0x20, 0x72, 0x65, 0x66, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x73, 0x75, 0x62, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x61, 0x73, 0x20,
+	0x24, 0x31, 0x2c, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x20, 0x69, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4a,
+	0x05, 0x22, 0x53, 0x45, 0x54, 0x22, 0xf2, 0x02, 0x03, 0x41, 0x44, 0x44, 0xf2, 0x02, 0x03, 0x53,
+	0x45, 0x54, 0xf2, 0x02, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0xf2, 0x02, 0x07, 0x52, 0x45,
+	0x50, 0x4c, 0x41, 0x43, 0x45, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xb9, 0x01,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0xa4, 0x01, 0xfa,
+	0x42, 0x26, 0x72, 0x24, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x1d, 0x5e, 0x5b, 0x21, 0x23, 0x24,
+	0x25, 0x26, 0x27, 0x2a, 0x2b, 0x2e, 0x5e, 0x5f, 0x60, 0x7c, 0x7e, 0x30, 0x2d, 0x39, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x2d, 0x5d, 0x2b, 0x24, 0x92, 0x41, 0x78, 0x32, 0x6e, 0x54, 0x68, 0x65,
+	0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x6d, 0x61, 0x79,
+	0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x77, 0x65, 0x6c, 0x6c, 0x4a, 0x06, 0x22, 0x48, 0x6f,
+	0x73, 0x74, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x53, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x20, 0x92, 0x41, 0x48, 0x32, 0x39, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x20, 0x61, 0x64, 0x64, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x6f,
+	0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x4a, 0x0b, 0x22, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7d, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02,
+	0x92, 0x41, 0x72, 0x32, 0x64, 0x54, 0x68, 0x65, 0x20, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72,
+	0x20, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x20, 0x62, 0x79, 0x20, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x2c, 0x20, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x20, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x0a, 0x22, 0x5e, 0x68, 0x74, 0x74,
+	0x70, 0x3a, 0x2f, 0x2f, 0x22, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0xe6,
+	0x2c, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xcf, 0x03, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xb2, 0x03, 0xfa, 0x42, 0x71, 0x72,
+	0x6f, 0x18, 0xfd, 0x01, 0x32, 0x67, 0x5e, 0x28, 0x5c, 0x2a, 0x5c, 0x2e, 0x29, 0x3f, 0x28, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c, 0x2e, 0x29, 0x2a, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c, 0x2e, 0x3f, 0x24, 0xd0, 0x01, 0x01,
+	0x92, 0x41, 0xba, 0x02, 0x32, 0xa4, 0x02, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20,
+	0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x2c, 0x20, 0x61,
+	0x20, 0x6c, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x27, 0x2a, 0x2e, 0x27, 0x20, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x73, 0x75, 0x62, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x20,
+	0x62, 0x65, 0x6c, 0x6f, 0x77, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x74, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x77,
+	0x68, 0x69, 0x63, 0x68, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x69, 0x74, 0x73, 0x65, 0x6c, 0x66, 0x2e, 0x20, 0x49,
+	0x66, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x20, 0x73, 0x75, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x62, 0x61, 0x73, 0x65, 0x20, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x2c, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x69, 0x6e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2c, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20,
+	0x69, 0x73, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x4a, 0x11, 0x22, 0x77, 0x77,
 	0x77, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08,
-	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x67, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x92, 0x41, 0x3b, 0x32, 0x11, 0x54, 0x68, 0x65, 0x20, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x64, 0x4a, 0x26, 0x22, 0x34,
-	0x36, 0x31, 0x65, 0x62, 0x61, 0x62, 0x63, 0x2d, 0x37, 0x35, 0x37, 0x61, 0x2d, 0x34, 0x31, 0x62,
-	0x65, 0x2d, 0x61, 0x31, 0x35, 0x64, 0x2d, 0x38, 0x39, 0x61, 0x66, 0x62, 0x65, 0x65, 0x34, 0x30,
-	0x37, 0x63, 0x39, 0x22, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49,
-	0x64, 0x12, 0x80, 0x01, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x46, 0x92, 0x41, 0x43, 0x32, 0x29, 0x54, 0x68, 0x65, 0x20, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x4a, 0x16, 0x22, 0x31, 0x39, 0x37, 0x30, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54, 0x30,
-	0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x76, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x32, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x16, 0x22, 0x31, 0x39, 0x37, 0x30,
-	0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a,
-	0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x80, 0x01, 0x0a,
-	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x46, 0x92, 0x41,
-	0x43, 0x32, 0x29, 0x54, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-	0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x16, 0x22, 0x31,
-	0x39, 0x37, 0x30, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a,
-	0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0xa6, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xa8, 0x01, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8d, 0x01, 0xfa,
-	0x42, 0x29, 0x72, 0x27, 0x52, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x52, 0x04, 0x50, 0x49, 0x4e, 0x47,
-	0x52, 0x04, 0x50, 0x4f, 0x4e, 0x47, 0x52, 0x04, 0x50, 0x55, 0x53, 0x48, 0x52, 0x06, 0x46, 0x49,
-	0x4e, 0x49, 0x53, 0x48, 0x52, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x92, 0x41, 0x5e, 0x32, 0x27,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x6e,
-	0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x06, 0x22, 0x49, 0x4e, 0x49, 0x54, 0x22, 0xf2,
-	0x02, 0x04, 0x49, 0x4e, 0x49, 0x54, 0xf2, 0x02, 0x04, 0x50, 0x49, 0x4e, 0x47, 0xf2, 0x02, 0x04,
-	0x50, 0x4f, 0x4e, 0x47, 0xf2, 0x02, 0x04, 0x50, 0x55, 0x53, 0x48, 0xf2, 0x02, 0x06, 0x46, 0x49,
-	0x4e, 0x49, 0x53, 0x48, 0xf2, 0x02, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x61, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x1a, 0x54, 0x68, 0x65,
-	0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x26, 0x22, 0x30, 0x45, 0x35, 0x39, 0x39, 0x30,
-	0x38, 0x36, 0x2d, 0x38, 0x33, 0x30, 0x31, 0x2d, 0x34, 0x38, 0x42, 0x30, 0x2d, 0x38, 0x37, 0x30,
-	0x33, 0x2d, 0x34, 0x44, 0x31, 0x42, 0x36, 0x46, 0x32, 0x32, 0x46, 0x32, 0x39, 0x35, 0x22, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xa7, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8d, 0x01, 0xfa, 0x42, 0x29, 0x72, 0x27, 0x52, 0x04,
-	0x49, 0x4e, 0x49, 0x54, 0x52, 0x04, 0x50, 0x49, 0x4e, 0x47, 0x52, 0x04, 0x50, 0x4f, 0x4e, 0x47,
-	0x52, 0x04, 0x50, 0x55, 0x53, 0x48, 0x52, 0x06, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x52, 0x05,
-	0x52, 0x45, 0x53, 0x45, 0x54, 0x92, 0x41, 0x5e, 0x32, 0x27, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4a, 0x06, 0x22, 0x50, 0x49, 0x4e, 0x47, 0x22, 0xf2, 0x02, 0x04, 0x49, 0x4e, 0x49, 0x54,
-	0xf2, 0x02, 0x04, 0x50, 0x49, 0x4e, 0x47, 0xf2, 0x02, 0x04, 0x50, 0x4f, 0x4e, 0x47, 0xf2, 0x02,
-	0x04, 0x50, 0x55, 0x53, 0x48, 0xf2, 0x02, 0x06, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0xf2, 0x02,
-	0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
-	0x61, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x1a, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x4a, 0x26, 0x22, 0x30, 0x45, 0x35, 0x39, 0x39, 0x30, 0x38, 0x36, 0x2d, 0x38, 0x33, 0x30,
-	0x31, 0x2d, 0x34, 0x38, 0x42, 0x30, 0x2d, 0x38, 0x37, 0x30, 0x33, 0x2d, 0x34, 0x44, 0x31, 0x42,
-	0x36, 0x46, 0x32, 0x32, 0x46, 0x32, 0x39, 0x35, 0x22, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x32, 0xee, 0x03, 0x0a, 0x11, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x70, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f,
-	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x0f, 0x12, 0x0b, 0x55, 0x73, 0x65,
-	0x72, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x62, 0x00, 0x12, 0xa8, 0x01, 0x0a, 0x0c, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70,
-	0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x92,
-	0x41, 0x31, 0x12, 0x2f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x73,
-	0x69, 0x64, 0x65, 0x30, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x10, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x3a, 0x01,
-	0x2a, 0x92, 0x41, 0x28, 0x12, 0x26, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x20, 0x74, 0x6f,
-	0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20,
-	0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x28, 0x01, 0x30, 0x01,
-	0x1a, 0x21, 0x92, 0x41, 0x1e, 0x12, 0x1c, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x32, 0x95, 0x03, 0x0a, 0x0e, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0xb2, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xf9, 0x01, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0xdc, 0x01, 0xfa, 0x42,
+	0x1e, 0x72, 0x1c, 0x52, 0x04, 0x48, 0x54, 0x54, 0x50, 0x52, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53,
+	0x52, 0x03, 0x54, 0x4c, 0x53, 0x52, 0x03, 0x54, 0x43, 0x50, 0x52, 0x03, 0x55, 0x44, 0x50, 0x92,
+	0x41, 0xb7, 0x01, 0x32, 0x8b, 0x01, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x2c, 0x20, 0x54, 0x4c, 0x53, 0x20, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6c, 0x73, 0x20, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x73, 0x20, 0x69, 0x73, 0x2c, 0x20, 0x54,
+	0x43, 0x50, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x55, 0x44, 0x50, 0x20, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x70, 0x6f, 0x72,
+	0x74, 0x4a, 0x06, 0x22, 0x48, 0x54, 0x54, 0x50, 0x22, 0xf2, 0x02, 0x04, 0x48, 0x54, 0x54, 0x50,
+	0xf2, 0x02, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0xf2, 0x02, 0x03, 0x54, 0x4c, 0x53, 0xf2, 0x02,
+	0x03, 0x54, 0x43, 0x50, 0xf2, 0x02, 0x03, 0x55, 0x44, 0x50, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x5f, 0x0a, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x43, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0x80, 0x08,
+	0x28, 0x00, 0x92, 0x41, 0x36, 0x32, 0x30, 0x54, 0x68, 0x65, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x4a, 0x02, 0x31, 0x32, 0x52, 0x08, 0x70, 0x6f, 0x6f,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x7c, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x68, 0xfa, 0x42, 0x08, 0x1a, 0x06, 0x18, 0xff, 0xff, 0x03, 0x28, 0x00,
+	0x92, 0x41, 0x5a, 0x32, 0x51, 0x54, 0x68, 0x65, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20,
+	0x70, 0x6f, 0x72, 0x74, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x62,
+	0x79, 0x20, 0x61, 0x20, 0x54, 0x43, 0x50, 0x20, 0x6f, 0x72, 0x20, 0x55, 0x44, 0x50, 0x20, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2c, 0x20, 0x61, 0x20, 0x66, 0x72, 0x65, 0x65, 0x20,
+	0x70, 0x6f, 0x72, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x20, 0x69, 0x66, 0x20, 0x30, 0x4a, 0x05, 0x32, 0x32, 0x30, 0x32, 0x32, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0xb4, 0x01, 0x0a, 0x05, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x9d, 0x01, 0x92, 0x41, 0x99, 0x01, 0x32, 0x90, 0x01, 0x54, 0x68, 0x65,
+	0x20, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x6e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x6f, 0x72, 0x20, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x73, 0x70, 0x65, 0x61,
+	0x6b, 0x73, 0x20, 0x48, 0x54, 0x54, 0x50, 0x2f, 0x32, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75,
+	0x74, 0x20, 0x74, 0x6c, 0x73, 0x20, 0x28, 0x68, 0x32, 0x63, 0x29, 0x2c, 0x20, 0x65, 0x2e, 0x67,
+	0x2e, 0x20, 0x61, 0x20, 0x67, 0x52, 0x50, 0x43, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2c,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x69,
+	0x74, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x20, 0x48, 0x54, 0x54, 0x50, 0x2f, 0x32, 0x4a, 0x04, 0x74,
+	0x72, 0x75, 0x65, 0x52, 0x05, 0x68, 0x74, 0x74, 0x70, 0x32, 0x12, 0xc9, 0x01, 0x0a, 0x09, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0xaa,
+	0x01, 0x92, 0x41, 0xa6, 0x01, 0x32, 0x9d, 0x01, 0x45, 0x76, 0x65, 0x72, 0x79, 0x20, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x2d, 0x6c,
+	0x69, 0x76, 0x65, 0x64, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x61, 0x72,
+	0x72, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x61, 0x6c, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2c, 0x20, 0x70, 0x6f,
+	0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x09, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x65, 0x78, 0x12, 0xd0, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0xad, 0x01, 0xfa,
+	0x42, 0x1d, 0x92, 0x01, 0x1a, 0x18, 0x01, 0x22, 0x16, 0x72, 0x14, 0x52, 0x04, 0x67, 0x7a, 0x69,
+	0x70, 0x52, 0x04, 0x7a, 0x73, 0x74, 0x64, 0x52, 0x06, 0x73, 0x6e, 0x61, 0x70, 0x70, 0x79, 0x92,
+	0x41, 0x89, 0x01, 0x32, 0x75, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x2c, 0x20,
+	0x69, 0x6e, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4a, 0x10, 0x5b, 0x22, 0x7a, 0x73,
+	0x74, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x67, 0x7a, 0x69, 0x70, 0x22, 0x5d, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0xd2, 0x02, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0xb5, 0x02, 0xfa,
+	0x42, 0x08, 0x72, 0x06, 0xb0, 0x01, 0x01, 0xd0, 0x01, 0x01, 0x92, 0x41, 0xa6, 0x02, 0x32, 0xfb,
+	0x01, 0x54, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x6b, 0x65, 0x79, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x69, 0x73, 0x20, 0x6b,
+	0x65, 0x70, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x2c,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x73, 0x20, 0x69, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73, 0x20,
+	0x70, 0x6f, 0x72, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x6e, 0x65, 0x77, 0x2c, 0x20, 0x61, 0x20, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x20, 0x73, 0x74, 0x69, 0x6c, 0x6c, 0x20, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x20, 0x69,
+	0x73, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x4a, 0x26, 0x22, 0x37,
+	0x41, 0x33, 0x42, 0x31, 0x44, 0x35, 0x32, 0x2d, 0x32, 0x41, 0x38, 0x43, 0x2d, 0x34, 0x42, 0x30,
+	0x43, 0x2d, 0x39, 0x45, 0x30, 0x45, 0x2d, 0x33, 0x41, 0x31, 0x46, 0x34, 0x42, 0x31, 0x43, 0x39,
+	0x44, 0x37, 0x45, 0x22, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x77,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x55, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0x80, 0x08, 0x28, 0x00, 0x92,
+	0x41, 0x48, 0x32, 0x43, 0x54, 0x68, 0x65, 0x20, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x73, 0x74,
+	0x20, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20, 0x69,
+	0x73, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x20, 0x74, 0x6f,
+	0x2c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6d, 0x61, 0x78, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x4a, 0x01, 0x32, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0xeb, 0x02, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0xc8, 0x02,
+	0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0x80, 0x08, 0x28, 0x00, 0x92, 0x41, 0xba, 0x02, 0x32, 0xb3,
+	0x02, 0x54, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x20, 0x73, 0x69, 0x7a,
+	0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x64, 0x20, 0x75, 0x70, 0x20, 0x74, 0x6f, 0x2e, 0x20, 0x49, 0x66, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x30, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
+	0x73, 0x69, 0x7a, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20, 0x62,
+	0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x20, 0x61, 0x74, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x3a,
+	0x20, 0x6d, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20,
+	0x73, 0x69, 0x7a, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x64, 0x6c,
+	0x65, 0x20, 0x6f, 0x6e, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x64, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x6e, 0x6f, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x2e, 0x20, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x78, 0x65, 0x64, 0x20, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4a, 0x02, 0x33, 0x32, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0xa0, 0x03, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0xf9, 0x02,
+	0xfa, 0x42, 0x2e, 0x72, 0x2c, 0x52, 0x00, 0x52, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52,
+	0x4f, 0x42, 0x49, 0x4e, 0x52, 0x11, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x52, 0x08, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45,
+	0x44, 0x92, 0x41, 0xc4, 0x02, 0x32, 0x82, 0x02, 0x54, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x2e, 0x20, 0x49, 0x66, 0x20, 0x73, 0x65,
+	0x74, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x77, 0x69,
+	0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2c, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x68, 0x74, 0x74, 0x70, 0x32, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x20, 0x69, 0x74, 0x20,
+	0x61, 0x73, 0x20, 0x77, 0x65, 0x6c, 0x6c, 0x2e, 0x20, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x77, 0x6e,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x6c, 0x79, 0x4a, 0x0d, 0x22, 0x52, 0x4f, 0x55,
+	0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x22, 0xf2, 0x02, 0x00, 0xf2, 0x02, 0x0b, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0xf2, 0x02, 0x11, 0x4c, 0x45, 0x41,
+	0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0xf2, 0x02,
+	0x08, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x52, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x93, 0x01, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x42, 0x7b, 0xfa, 0x42, 0x06, 0x1a, 0x04,
+	0x18, 0x64, 0x28, 0x00, 0x92, 0x41, 0x6f, 0x32, 0x6a, 0x54, 0x68, 0x65, 0x20, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x57, 0x45,
+	0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2c, 0x20, 0x31, 0x20, 0x69,
+	0x66, 0x20, 0x30, 0x4a, 0x01, 0x33, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x98,
+	0x03, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0xf7, 0x02, 0xfa, 0x42, 0x22, 0x72, 0x20, 0x18, 0x80, 0x02, 0x32, 0x18,
+	0x5e, 0x28, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x7e,
+	0x2d, 0x5d, 0x2b, 0x29, 0x2a, 0x2f, 0x3f, 0x24, 0xd0, 0x01, 0x01, 0x92, 0x41, 0xce, 0x02, 0x32,
+	0xc4, 0x02, 0x54, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x6f, 0x72, 0x20,
+	0x48, 0x54, 0x54, 0x50, 0x53, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x2e, 0x20,
+	0x41, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x20, 0x77, 0x68, 0x6f, 0x6c, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2c, 0x20, 0x2f, 0x76, 0x31, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x20, 0x2f, 0x76, 0x31, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x20, 0x62, 0x75, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x2f, 0x76, 0x31, 0x30,
+	0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x20, 0x77, 0x69, 0x6e, 0x73, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x6f, 0x75, 0x74, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x20, 0x70, 0x61, 0x74, 0x68, 0x73, 0x4a, 0x05, 0x22, 0x2f, 0x76, 0x31, 0x22, 0x52, 0x0a, 0x70,
+	0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0xa8, 0x01, 0x0a, 0x0b, 0x73, 0x74,
+	0x72, 0x69, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x85, 0x01, 0x92, 0x41, 0x81, 0x01, 0x32, 0x79, 0x54, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68,
+	0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x20, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2c,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x20, 0x69, 0x73, 0x20, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x70, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x81, 0x02, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0xda, 0x01, 0xfa,
+	0x42, 0x22, 0x72, 0x20, 0x18, 0x80, 0x02, 0x32, 0x18, 0x5e, 0x28, 0x2f, 0x5b, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x7e, 0x2d, 0x5d, 0x2b, 0x29, 0x2a, 0x2f, 0x3f,
+	0x24, 0xd0, 0x01, 0x01, 0x92, 0x41, 0xb1, 0x01, 0x32, 0xa6, 0x01, 0x54, 0x68, 0x65, 0x20, 0x70,
+	0x61, 0x74, 0x68, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x6f,
+	0x6e, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2c, 0x20, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x20, 0x69, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2e, 0x20, 0x4e, 0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x74, 0x72, 0x69, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x4a, 0x06, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x22, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x84, 0x02, 0x0a, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x42,
+	0xbd, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x40, 0x92, 0x41, 0xb1, 0x01, 0x32, 0xae,
+	0x01, 0x54, 0x68, 0x65, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x20, 0x72, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x6f, 0x72, 0x20, 0x48,
+	0x54, 0x54, 0x50, 0x53, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2c, 0x20, 0x69, 0x6e,
+	0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2c, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x58, 0x2d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2d, 0x2a, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x20, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x64, 0x64, 0x65, 0x64, 0x52,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x80, 0x02, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x42, 0xb7, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
+	0x10, 0x40, 0x92, 0x41, 0xab, 0x01, 0x32, 0xa8, 0x01, 0x54, 0x68, 0x65, 0x20, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x20, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x6e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x6f, 0x72, 0x20, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2c, 0x20, 0x69, 0x6e, 0x20,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x69,
+	0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0xf2, 0x02, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x69, 0x64, 0x72,
+	0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x42, 0xd1, 0x02, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b,
+	0x10, 0x80, 0x02, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x92, 0x41, 0xbc, 0x02, 0x32,
+	0x94, 0x02, 0x54, 0x68, 0x65, 0x20, 0x43, 0x49, 0x44, 0x52, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x72, 0x65, 0x61, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2c, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x20, 0x54, 0x68, 0x65,
+	0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x66,
+	0x75, 0x73, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x6e, 0x79, 0x20,
+	0x62, 0x79, 0x74, 0x65, 0x20, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x73, 0x20, 0x61, 0x20, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x20, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x20, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x58,
+	0x2d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2d, 0x46, 0x6f, 0x72, 0x20, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4a, 0x23, 0x5b, 0x22, 0x32, 0x30, 0x33, 0x2e, 0x30, 0x2e,
+	0x31, 0x31, 0x33, 0x2e, 0x30, 0x2f, 0x32, 0x34, 0x22, 0x2c, 0x20, 0x22, 0x32, 0x30, 0x30, 0x31,
+	0x3a, 0x64, 0x62, 0x38, 0x3a, 0x3a, 0x2f, 0x33, 0x32, 0x22, 0x5d, 0x52, 0x0a, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x43, 0x69, 0x64, 0x72, 0x73, 0x12, 0xaa, 0x01, 0x0a, 0x09, 0x64, 0x65, 0x6e, 0x79,
+	0x43, 0x69, 0x64, 0x72, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x42, 0x8b, 0x01, 0xfa, 0x42,
+	0x0e, 0x92, 0x01, 0x0b, 0x10, 0x80, 0x02, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x92,
+	0x41, 0x77, 0x32, 0x63, 0x54, 0x68, 0x65, 0x20, 0x43, 0x49, 0x44, 0x52, 0x20, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x72, 0x65, 0x66, 0x75, 0x73, 0x65, 0x64,
+	0x20, 0x65, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x43, 0x69, 0x64, 0x72, 0x73, 0x4a, 0x10, 0x5b, 0x22, 0x31, 0x39, 0x38, 0x2e, 0x35,
+	0x31, 0x2e, 0x31, 0x30, 0x30, 0x2e, 0x37, 0x22, 0x5d, 0x52, 0x09, 0x64, 0x65, 0x6e, 0x79, 0x43,
+	0x69, 0x64, 0x72, 0x73, 0x12, 0xb9, 0x02, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75,
+	0x74, 0x68, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x42, 0x9a, 0x02, 0xfa, 0x42, 0x18, 0x92, 0x01,
+	0x15, 0x10, 0x40, 0x22, 0x11, 0x72, 0x0f, 0x18, 0x80, 0x02, 0x32, 0x0a, 0x5e, 0x5b, 0x5e, 0x3a,
+	0x5d, 0x2b, 0x3a, 0x2e, 0x2b, 0x24, 0x92, 0x41, 0xfb, 0x01, 0x32, 0xe6, 0x01, 0x54, 0x68, 0x65,
+	0x20, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x20, 0x70, 0x61, 0x69, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x48,
+	0x54, 0x54, 0x50, 0x20, 0x6f, 0x72, 0x20, 0x48, 0x54, 0x54, 0x50, 0x53, 0x20, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2c, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x6e,
+	0x79, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65,
+	0x73, 0x20, 0x61, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x20, 0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x4a, 0x10, 0x5b, 0x22, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x3a, 0x73, 0x33, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x5d, 0x52, 0x09, 0x62, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x82, 0x02, 0x0a, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x42, 0xdd, 0x01, 0xfa, 0x42, 0x24, 0x92, 0x01, 0x21,
+	0x10, 0x40, 0x22, 0x1d, 0x72, 0x1b, 0x18, 0x80, 0x02, 0x32, 0x16, 0x5e, 0x5b, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x7e, 0x2b, 0x2f, 0x2d, 0x5d, 0x2b, 0x3d, 0x2a,
+	0x24, 0x92, 0x41, 0xb2, 0x01, 0x32, 0x89, 0x01, 0x54, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x20, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x20, 0x61,
+	0x73, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x48, 0x54, 0x54, 0x50,
+	0x20, 0x6f, 0x72, 0x20, 0x48, 0x54, 0x54, 0x50, 0x53, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2c, 0x20, 0x62, 0x65, 0x73, 0x69, 0x64, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x4a, 0x24, 0x5b, 0x22, 0x39, 0x66, 0x38, 0x36, 0x64, 0x30, 0x38, 0x31, 0x38, 0x38, 0x34,
+	0x63, 0x37, 0x64, 0x36, 0x35, 0x39, 0x61, 0x32, 0x66, 0x65, 0x61, 0x61, 0x30, 0x63, 0x35, 0x35,
+	0x61, 0x64, 0x30, 0x31, 0x35, 0x22, 0x5d, 0x52, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x9f, 0x02, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x42, 0xfe, 0x01, 0xfa, 0x42, 0x08,
+	0x1a, 0x06, 0x28, 0x80, 0x80, 0x04, 0x40, 0x01, 0x92, 0x41, 0xef, 0x01, 0x32, 0xe3, 0x01, 0x54,
+	0x68, 0x65, 0x20, 0x66, 0x6c, 0x6f, 0x77, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x20,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20,
+	0x6f, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x20,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74,
+	0x6f, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x20, 0x69, 0x74, 0x20,
+	0x62, 0x79, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x20, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x75, 0x73, 0x65, 0x73, 0x20, 0x69,
+	0x74, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x69, 0x66,
+	0x20, 0x30, 0x4a, 0x07, 0x31, 0x30, 0x34, 0x38, 0x35, 0x37, 0x36, 0x52, 0x0a, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xfa, 0x08, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x72, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x58, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x92, 0x41, 0x4d, 0x32, 0x23,
+	0x54, 0x68, 0x65, 0x20, 0x69, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x4a, 0x26, 0x22, 0x30, 0x32, 0x37, 0x38, 0x33, 0x33, 0x43, 0x30, 0x2d, 0x34,
+	0x34, 0x34, 0x35, 0x2d, 0x34, 0x45, 0x30, 0x33, 0x2d, 0x38, 0x42, 0x31, 0x37, 0x2d, 0x45, 0x42,
+	0x44, 0x42, 0x33, 0x43, 0x38, 0x44, 0x34, 0x46, 0x33, 0x41, 0x22, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0xb1, 0x01, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8e, 0x01, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x92, 0x41, 0x83, 0x01, 0x32, 0x22, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x57,
+	0x65, 0x62, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x20, 0x57, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4a, 0x5d, 0x22, 0x65,
+	0x79, 0x4a, 0x68, 0x62, 0x47, 0x63, 0x69, 0x4f, 0x69, 0x4a, 0x49, 0x55, 0x7a, 0x49, 0x31, 0x4e,
+	0x69, 0x49, 0x73, 0x49, 0x6e, 0x52, 0x35, 0x63, 0x43, 0x49, 0x36, 0x49, 0x6b, 0x70, 0x58, 0x56,
+	0x43, 0x4a, 0x39, 0x2e, 0x65, 0x79, 0x4a, 0x68, 0x49, 0x6a, 0x6f, 0x78, 0x66, 0x51, 0x2e, 0x5a,
+	0x34, 0x72, 0x47, 0x4b, 0x2d, 0x76, 0x36, 0x61, 0x32, 0x73, 0x57, 0x41, 0x55, 0x51, 0x64, 0x6d,
+	0x41, 0x4c, 0x52, 0x33, 0x61, 0x59, 0x62, 0x58, 0x5a, 0x76, 0x69, 0x4c, 0x72, 0x38, 0x6a, 0x32,
+	0x36, 0x61, 0x39, 0x6e, 0x64, 0x78, 0x5f, 0x62, 0x4d, 0x34, 0x22, 0x52, 0x0b, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x48, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32, 0x28, 0x54, 0x68, 0x65,
+	0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20,
+	0x61, 0x20, 0x54, 0x43, 0x50, 0x20, 0x6f, 0x72, 0x20, 0x55, 0x44, 0x50, 0x20, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x05, 0x32, 0x32, 0x30, 0x32, 0x32, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0xf2, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0xcf, 0x01, 0x92, 0x41, 0xcb, 0x01, 0x32,
+	0xc0, 0x01, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x20, 0x6e, 0x65, 0x67, 0x6f,
+	0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x20, 0x77, 0x68, 0x65, 0x72, 0x65, 0x20, 0x69, 0x74, 0x20,
+	0x68, 0x65, 0x6c, 0x70, 0x73, 0x2e, 0x20, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66, 0x20,
+	0x6e, 0x6f, 0x6e, 0x65, 0x20, 0x69, 0x73, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x54, 0x4c, 0x53, 0x20,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x4a, 0x06, 0x22, 0x7a, 0x73, 0x74, 0x64, 0x22, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0xbb, 0x01, 0x0a, 0x0a, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x9a, 0x01, 0x92,
+	0x41, 0x96, 0x01, 0x32, 0x6c, 0x54, 0x68, 0x65, 0x20, 0x69, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x2c, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x6e, 0x63, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x4a, 0x26, 0x22, 0x37, 0x41, 0x33, 0x42, 0x31, 0x44, 0x35, 0x32, 0x2d, 0x32, 0x41, 0x38,
+	0x43, 0x2d, 0x34, 0x42, 0x30, 0x43, 0x2d, 0x39, 0x45, 0x30, 0x45, 0x2d, 0x33, 0x41, 0x31, 0x46,
+	0x34, 0x42, 0x31, 0x43, 0x39, 0x44, 0x37, 0x45, 0x22, 0x52, 0x0a, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x8d, 0x01, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x71, 0x92, 0x41, 0x6e, 0x32, 0x45, 0x54,
+	0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x2c, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62,
+	0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x69, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20,
+	0x6e, 0x6f, 0x6e, 0x65, 0x4a, 0x25, 0x22, 0x62, 0x72, 0x61, 0x76, 0x65, 0x2d, 0x6f, 0x74, 0x74,
+	0x65, 0x72, 0x2d, 0x34, 0x38, 0x32, 0x31, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xac, 0x01, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x8b, 0x01, 0x92, 0x41, 0x87,
+	0x01, 0x32, 0x7c, 0x54, 0x68, 0x65, 0x20, 0x66, 0x6c, 0x6f, 0x77, 0x20, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x20, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x62, 0x6f, 0x74, 0x68, 0x20, 0x73, 0x69, 0x64, 0x65, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4a,
+	0x07, 0x31, 0x30, 0x34, 0x38, 0x35, 0x37, 0x36, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0xc6, 0x01, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0xa9, 0x01, 0xfa, 0x42, 0x70, 0x72, 0x6e, 0x10, 0x01, 0x18, 0xfd, 0x01,
+	0x32, 0x67, 0x5e, 0x28, 0x5c, 0x2a, 0x5c, 0x2e, 0x29, 0x3f, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c, 0x2e, 0x29, 0x2a, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30,
+	0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c, 0x2e, 0x3f, 0x24, 0x92, 0x41, 0x33, 0x32, 0x1e, 0x48, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x62,
+	0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x11, 0x22, 0x77,
+	0x77, 0x77, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xa9, 0x01, 0x0a, 0x0a, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x88,
+	0x01, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xb0, 0x01, 0x01, 0xd0, 0x01, 0x01, 0x92, 0x41, 0x7a, 0x32,
+	0x50, 0x54, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x6f, 0x66,
+	0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x4a, 0x26, 0x22, 0x37, 0x41, 0x33, 0x42, 0x31, 0x44, 0x35, 0x32, 0x2d, 0x32, 0x41, 0x38,
+	0x43, 0x2d, 0x34, 0x42, 0x30, 0x43, 0x2d, 0x39, 0x45, 0x30, 0x45, 0x2d, 0x33, 0x41, 0x31, 0x46,
+	0x34, 0x42, 0x31, 0x43, 0x39, 0x44, 0x37, 0x45, 0x22, 0x52, 0x0a, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0xf8, 0x11, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x63, 0x0a, 0x0a, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41, 0x40, 0x32, 0x16, 0x54, 0x68, 0x65,
+	0x20, 0x69, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4a, 0x26, 0x22, 0x37, 0x41, 0x33, 0x42, 0x31, 0x44, 0x35, 0x32, 0x2d, 0x32,
+	0x41, 0x38, 0x43, 0x2d, 0x34, 0x42, 0x30, 0x43, 0x2d, 0x39, 0x45, 0x30, 0x45, 0x2d, 0x33, 0x41,
+	0x31, 0x46, 0x34, 0x42, 0x31, 0x43, 0x39, 0x44, 0x37, 0x45, 0x22, 0x52, 0x0a, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x32, 0x18,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x11, 0x22, 0x77, 0x77, 0x77, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xc7, 0x01, 0x0a, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0xaa, 0x01, 0x92, 0x41, 0xa6, 0x01, 0x32,
+	0xa0, 0x01, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0x73, 0x69, 0x7a,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x2c, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x20, 0x69, 0x74, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c,
+	0x79, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x6b, 0x65, 0x65, 0x70, 0x20, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x20, 0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x69, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x64, 0x4a, 0x01, 0x38, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x47, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x1d, 0x54, 0x68, 0x65, 0x20, 0x73,
+	0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x73, 0x74, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x4a, 0x01, 0x32, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x64, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x42, 0x92,
+	0x41, 0x3f, 0x32, 0x39, 0x54, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x20,
+	0x73, 0x69, 0x7a, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c,
+	0x2c, 0x20, 0x30, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20,
+	0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x4a, 0x02, 0x33,
+	0x32, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4e,
+	0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3a, 0x92, 0x41,
+	0x37, 0x32, 0x32, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66,
+	0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x20, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65,
+	0x20, 0x75, 0x73, 0x65, 0x64, 0x4a, 0x01, 0x36, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12, 0x63,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x4b,
+	0x92, 0x41, 0x48, 0x32, 0x43, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c,
+	0x79, 0x20, 0x69, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x4a, 0x01, 0x33, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x6d, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x53, 0x92, 0x41, 0x50, 0x32, 0x4b, 0x54, 0x68, 0x65, 0x20, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x20, 0x79, 0x65, 0x74, 0x4a, 0x01, 0x32, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2b, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x20, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x01, 0x30, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x54, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x30, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x78, 0x65,
+	0x64, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x01, 0x30, 0x52, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0a, 0x70, 0x65, 0x61, 0x6b,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x64, 0x92, 0x41,
+	0x61, 0x32, 0x5c, 0x54, 0x68, 0x65, 0x20, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x20, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20, 0x77, 0x61, 0x73, 0x20,
+	0x6c, 0x61, 0x73, 0x74, 0x20, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x4a,
+	0x01, 0x35, 0x52, 0x0a, 0x70, 0x65, 0x61, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x69,
+	0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x4d, 0x92, 0x41, 0x4a, 0x32, 0x42, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x20, 0x68, 0x61, 0x6e,
+	0x64, 0x65, 0x64, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x77, 0x61, 0x73, 0x20, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4a, 0x04, 0x31, 0x30, 0x32, 0x34, 0x52,
+	0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x08, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x55, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x32, 0x92, 0x41, 0x2f,
+	0x32, 0x2a, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20, 0x77,
+	0x61, 0x73, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x20, 0x75, 0x70, 0x4a, 0x01, 0x33, 0x52,
+	0x08, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x55, 0x70, 0x73, 0x12, 0x54, 0x0a, 0x0a, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x34, 0x92,
+	0x41, 0x31, 0x32, 0x2c, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c,
+	0x20, 0x77, 0x61, 0x73, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x20, 0x64, 0x6f, 0x77, 0x6e,
+	0x4a, 0x01, 0x31, 0x52, 0x0a, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x12,
+	0x8e, 0x01, 0x0a, 0x0e, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x61, 0x69, 0x74, 0x41,
+	0x76, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x4b, 0x92, 0x41, 0x48, 0x32, 0x3c, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x6f,
+	0x76, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x77, 0x61, 0x69, 0x74, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x08, 0x22, 0x30, 0x2e, 0x30, 0x30, 0x32, 0x73, 0x22,
+	0x52, 0x0e, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x61, 0x69, 0x74, 0x41, 0x76, 0x67,
+	0x12, 0xb3, 0x01, 0x0a, 0x0e, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57, 0x61, 0x69, 0x74,
+	0x4d, 0x61, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x92, 0x41, 0x6d, 0x32, 0x61, 0x54, 0x68, 0x65, 0x20, 0x6c,
+	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x61, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x77, 0x61, 0x69, 0x74, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x61, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6f, 0x6c, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6c, 0x61, 0x73,
+	0x74, 0x20, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x4a, 0x08, 0x22, 0x30,
+	0x2e, 0x31, 0x35, 0x30, 0x73, 0x22, 0x52, 0x0e, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x57,
+	0x61, 0x69, 0x74, 0x4d, 0x61, 0x78, 0x12, 0xad, 0x01, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x86,
+	0x01, 0x92, 0x41, 0x82, 0x01, 0x32, 0x71, 0x54, 0x68, 0x65, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x20, 0x69, 0x74, 0x73, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2c, 0x20, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x20, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x6c, 0x79, 0x4a, 0x0d, 0x22, 0x52, 0x4f, 0x55, 0x4e, 0x44,
+	0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x22, 0x52, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x53, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x33, 0x54, 0x68, 0x65,
+	0x20, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x4a, 0x01, 0x31, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x87, 0x01, 0x0a, 0x0b,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x65, 0x92, 0x41, 0x62, 0x32, 0x36, 0x54, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4a, 0x28,
+	0x5b, 0x22, 0x37, 0x41, 0x33, 0x42, 0x31, 0x44, 0x35, 0x32, 0x2d, 0x32, 0x41, 0x38, 0x43, 0x2d,
+	0x34, 0x42, 0x30, 0x43, 0x2d, 0x39, 0x45, 0x30, 0x45, 0x2d, 0x33, 0x41, 0x31, 0x46, 0x34, 0x42,
+	0x31, 0x43, 0x39, 0x44, 0x37, 0x45, 0x22, 0x5d, 0x52, 0x0b, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x79, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x59, 0x92, 0x41, 0x56, 0x32, 0x4d,
+	0x54, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66,
+	0x20, 0x69, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77,
+	0x68, 0x6f, 0x6c, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x4a, 0x05, 0x22,
+	0x2f, 0x76, 0x31, 0x22, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x22, 0xec, 0x05, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xc6, 0x01, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xa9, 0x01, 0xfa, 0x42,
+	0x70, 0x72, 0x6e, 0x10, 0x01, 0x18, 0xfd, 0x01, 0x32, 0x67, 0x5e, 0x28, 0x5c, 0x2a, 0x5c, 0x2e,
+	0x29, 0x3f, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x31,
+	0x7d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c, 0x2e,
+	0x29, 0x2a, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x31, 0x7d,
+	0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c, 0x2e, 0x3f,
+	0x24, 0x92, 0x41, 0x33, 0x32, 0x1e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x11, 0x22, 0x77, 0x77, 0x77, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x87, 0x01, 0x0a, 0x0a, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x67, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x92, 0x41, 0x5c, 0x32, 0x32, 0x54, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x26, 0x22, 0x37, 0x41, 0x33, 0x42, 0x31, 0x44,
+	0x35, 0x32, 0x2d, 0x32, 0x41, 0x38, 0x43, 0x2d, 0x34, 0x42, 0x30, 0x43, 0x2d, 0x39, 0x45, 0x30,
+	0x45, 0x2d, 0x33, 0x41, 0x31, 0x46, 0x34, 0x42, 0x31, 0x43, 0x39, 0x44, 0x37, 0x45, 0x22, 0x52,
+	0x0a, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x96, 0x02, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0xfb, 0x01,
+	0x92, 0x41, 0xf7, 0x01, 0x32, 0xed, 0x01, 0x57, 0x68, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x20, 0x70, 0x61, 0x73, 0x73, 0x65, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x20, 0x72, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x6e, 0x20, 0x75, 0x6e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2c, 0x20, 0x69,
+	0x74, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x20, 0x61, 0x20,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x70, 0x61, 0x67, 0x65,
+	0x2c, 0x20, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x20, 0x61,
+	0x67, 0x61, 0x69, 0x6e, 0x4a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x12, 0x69, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x51, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x92, 0x41,
+	0x46, 0x32, 0x1b, 0x57, 0x68, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4a, 0x27,
+	0x22, 0x47, 0x45, 0x54, 0x20, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x3a, 0x20, 0x35,
+	0x30, 0x33, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x55, 0x6e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x71, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1a, 0x54, 0x68,
+	0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x0b, 0x22, 0x55, 0x4e, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x59, 0x22, 0xf2, 0x02, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0xf2, 0x02,
+	0x09, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xdb, 0x06, 0x0a, 0x0d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0xaa, 0x01, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8f, 0x01, 0xfa, 0x42, 0x2b, 0x72, 0x29, 0x52, 0x04,
+	0x50, 0x49, 0x4e, 0x47, 0x52, 0x04, 0x50, 0x4f, 0x4e, 0x47, 0x52, 0x04, 0x50, 0x55, 0x53, 0x48,
+	0x52, 0x06, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x52, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x52,
+	0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x92, 0x41, 0x5e, 0x32, 0x25, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4a, 0x06, 0x22, 0x50, 0x49, 0x4e, 0x47, 0x22, 0xf2, 0x02, 0x04, 0x50, 0x49, 0x4e, 0x47,
+	0xf2, 0x02, 0x04, 0x50, 0x4f, 0x4e, 0x47, 0xf2, 0x02, 0x04, 0x50, 0x55, 0x53, 0x48, 0xf2, 0x02,
+	0x06, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0xf2, 0x02, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0xf2,
+	0x02, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x61, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x1a, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x4a, 0x26, 0x22, 0x30, 0x45, 0x35, 0x39, 0x39, 0x30, 0x38, 0x36, 0x2d, 0x38,
+	0x33, 0x30, 0x31, 0x2d, 0x34, 0x38, 0x42, 0x30, 0x2d, 0x38, 0x37, 0x30, 0x33, 0x2d, 0x34, 0x44,
+	0x31, 0x42, 0x36, 0x46, 0x32, 0x32, 0x46, 0x32, 0x39, 0x35, 0x22, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0xbd, 0x01, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0xa0, 0x01, 0x92, 0x41, 0x9c, 0x01, 0x32, 0x96,
+	0x01, 0x54, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x78, 0x65, 0x64, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x20,
+	0x74, 0x6f, 0x2c, 0x20, 0x6f, 0x64, 0x64, 0x20, 0x69, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64,
+	0x73, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2c,
+	0x20, 0x30, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x20, 0x69, 0x74, 0x73, 0x65, 0x6c, 0x66, 0x4a, 0x01, 0x31, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x73, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x5b, 0x92, 0x41, 0x58, 0x32, 0x4f, 0x54, 0x68, 0x65, 0x20, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x20, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x65, 0x65, 0x72, 0x20, 0x74, 0x6f,
+	0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x6d, 0x6f, 0x72, 0x65, 0x4a, 0x05, 0x33, 0x32, 0x37, 0x36,
+	0x38, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x84, 0x02, 0x0a, 0x08, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0xe7, 0x01, 0xfa,
+	0x42, 0x18, 0x72, 0x16, 0x52, 0x00, 0x52, 0x04, 0x67, 0x7a, 0x69, 0x70, 0x52, 0x04, 0x7a, 0x73,
+	0x74, 0x64, 0x52, 0x06, 0x73, 0x6e, 0x61, 0x70, 0x70, 0x79, 0x92, 0x41, 0xc8, 0x01, 0x32, 0xa3,
+	0x01, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68,
+	0x20, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x50, 0x55, 0x53,
+	0x48, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x2e, 0x20, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x69, 0x73, 0x20, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x6f,
+	0x72, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x67, 0x65, 0x74, 0x20, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x61,
+	0x73, 0x20, 0x69, 0x73, 0x4a, 0x06, 0x22, 0x7a, 0x73, 0x74, 0x64, 0x22, 0xf2, 0x02, 0x00, 0xf2,
+	0x02, 0x04, 0x67, 0x7a, 0x69, 0x70, 0xf2, 0x02, 0x04, 0x7a, 0x73, 0x74, 0x64, 0xf2, 0x02, 0x06,
+	0x73, 0x6e, 0x61, 0x70, 0x70, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0xcf, 0x03, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xf0, 0x01,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0xd3, 0x01, 0xfa, 0x42, 0x70, 0x72, 0x6e, 0x10, 0x01, 0x18, 0xfd, 0x01, 0x32, 0x67, 0x5e,
+	0x28, 0x5c, 0x2a, 0x5c, 0x2e, 0x29, 0x3f, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30,
+	0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d,
+	0x7b, 0x30, 0x2c, 0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5d, 0x29, 0x3f, 0x5c, 0x2e, 0x29, 0x2a, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5d, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b,
+	0x30, 0x2c, 0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
+	0x29, 0x3f, 0x5c, 0x2e, 0x3f, 0x24, 0x92, 0x41, 0x5d, 0x32, 0x4a, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2c,
+	0x20, 0x61, 0x20, 0x6c, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x27, 0x2a, 0x2e, 0x27, 0x20,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x73, 0x75, 0x62, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4a, 0x0f, 0x22, 0x2a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x63, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x92, 0x41,
+	0x37, 0x32, 0x35, 0x50, 0x45, 0x4d, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x20, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2c, 0x20, 0x6c, 0x65, 0x61, 0x66, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x92, 0x41, 0x31, 0x32, 0x2f, 0x50, 0x45, 0x4d, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x20, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x65, 0x61, 0x66, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x22, 0xb5, 0x02, 0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5d, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x41, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x92, 0x41, 0x37, 0x32, 0x24,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x4a, 0x0f, 0x22, 0x2a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x67, 0x0a, 0x08, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x4b, 0x92, 0x41, 0x48, 0x32, 0x24, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x20, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4a, 0x20, 0x5b, 0x22,
+	0x2a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x2c, 0x20,
+	0x22, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x5d, 0x52, 0x08,
+	0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x92, 0x41, 0x28, 0x32, 0x26, 0x54, 0x68, 0x65, 0x20, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf2, 0x01, 0x0a, 0x12, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0xdb, 0x01, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0xbe, 0x01, 0xfa, 0x42, 0x69, 0x72, 0x67, 0x10, 0x01, 0x18, 0xfd,
+	0x01, 0x32, 0x60, 0x5e, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
+	0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c,
+	0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f,
+	0x5c, 0x2e, 0x29, 0x2b, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28,
+	0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36,
+	0x31, 0x7d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c,
+	0x2e, 0x3f, 0x24, 0x92, 0x41, 0x4f, 0x32, 0x39, 0x54, 0x68, 0x65, 0x20, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65,
+	0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x2c,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72,
+	0x64, 0x4a, 0x12, 0x22, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x91, 0x07, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x19,
+	0x54, 0x68, 0x65, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x4a, 0x12, 0x22, 0x61, 0x70, 0x70, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7e, 0x92, 0x41, 0x7b, 0x32, 0x59, 0x56,
+	0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x6d,
+	0x61, 0x79, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x69, 0x74, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+	0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x6f, 0x6e, 0x4a, 0x09, 0x22, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x22, 0xf2, 0x02, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0xf2, 0x02, 0x08,
+	0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x80, 0x01, 0x0a, 0x07, 0x74, 0x78, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x66, 0x92, 0x41, 0x63, 0x32, 0x3e, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x54, 0x58, 0x54, 0x20, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4a, 0x21, 0x22, 0x5f, 0x6b, 0x75, 0x6e, 0x2d, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x07, 0x74, 0x78, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x08, 0x74, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x3f, 0x54, 0x68, 0x65,
+	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x54, 0x58,
+	0x54, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4a, 0x22, 0x22, 0x39,
+	0x66, 0x38, 0x36, 0x64, 0x30, 0x38, 0x31, 0x38, 0x38, 0x34, 0x63, 0x37, 0x64, 0x36, 0x35, 0x39,
+	0x61, 0x32, 0x66, 0x65, 0x61, 0x61, 0x30, 0x63, 0x35, 0x35, 0x61, 0x64, 0x30, 0x31, 0x35, 0x22,
+	0x52, 0x08, 0x74, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x98, 0x02, 0x0a, 0x0b, 0x63,
+	0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0xf5, 0x01, 0x92, 0x41, 0xf1, 0x01, 0x32, 0xb9, 0x01, 0x54, 0x68, 0x65, 0x20, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x43, 0x4e, 0x41, 0x4d,
+	0x45, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x69,
+	0x74, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x20, 0x69, 0x6e, 0x73,
+	0x74, 0x65, 0x61, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x54, 0x58, 0x54, 0x20,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x64, 0x67, 0x65, 0x20, 0x61, 0x74,
+	0x20, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x73, 0x20, 0x54, 0x58, 0x54, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x6f,
+	0x6e, 0x6c, 0x79, 0x4a, 0x33, 0x22, 0x39, 0x66, 0x38, 0x36, 0x64, 0x30, 0x38, 0x31, 0x38, 0x38,
+	0x34, 0x63, 0x37, 0x64, 0x36, 0x35, 0x39, 0x61, 0x32, 0x66, 0x65, 0x61, 0x61, 0x30, 0x63, 0x35,
+	0x35, 0x61, 0x64, 0x30, 0x31, 0x35, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x6c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4e, 0x92, 0x41, 0x4b, 0x32, 0x49, 0x54,
+	0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x69, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x67,
+	0x6f, 0x74, 0x74, 0x65, 0x6e, 0x20, 0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x69, 0x74, 0x20,
+	0x69, 0x73, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74,
+	0x68, 0x65, 0x6e, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x20,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xc9, 0x01, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xac,
+	0x01, 0xfa, 0x42, 0x69, 0x72, 0x67, 0x10, 0x01, 0x18, 0xfd, 0x01, 0x32, 0x60, 0x5e, 0x28, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c, 0x2e, 0x29, 0x2b, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x36, 0x31, 0x7d, 0x5b, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x5c, 0x2e, 0x3f, 0x24, 0x92, 0x41, 0x3d,
+	0x32, 0x27, 0x54, 0x68, 0x65, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x12, 0x22, 0x61, 0x70, 0x70, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x1a, 0x54, 0x68, 0x65, 0x20, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x4a, 0x12, 0x22, 0x61, 0x70, 0x70, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x17, 0x54, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x4a,
+	0x0a, 0x22, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x22, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x65, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4d, 0x92, 0x41, 0x4a, 0x32, 0x33, 0x54, 0x68, 0x65, 0x20, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4a, 0x05, 0x22,
+	0x54, 0x58, 0x54, 0x22, 0xf2, 0x02, 0x03, 0x54, 0x58, 0x54, 0xf2, 0x02, 0x05, 0x43, 0x4e, 0x41,
+	0x4d, 0x45, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x54, 0x0a, 0x0a, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34,
+	0x92, 0x41, 0x31, 0x32, 0x2f, 0x54, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x20, 0x77, 0x61, 0x73, 0x20, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x94, 0x01, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x24,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x4a, 0x16, 0x22, 0x31, 0x39, 0x37, 0x30, 0x2d, 0x30, 0x31, 0x2d, 0x30,
+	0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc9, 0x07, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x68, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xfa, 0x42, 0x1c, 0x72, 0x1a, 0x52, 0x05, 0x41, 0x44,
+	0x44, 0x45, 0x44, 0x52, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x52, 0x07, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x92, 0x41, 0x28, 0x32, 0x1d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x07, 0x22, 0x41, 0x44, 0x44, 0x45, 0x44,
+	0x22, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x67, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x57, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x92, 0x41, 0x4c, 0x32, 0x22, 0x54, 0x68, 0x65, 0x20, 0x67, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x6c, 0x79, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x49, 0x44, 0x20, 0x6f, 0x66,
+	0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x26, 0x22, 0x34, 0x38, 0x31, 0x65,
+	0x33, 0x63, 0x39, 0x37, 0x2d, 0x36, 0x33, 0x38, 0x63, 0x2d, 0x34, 0x62, 0x38, 0x66, 0x2d, 0x62,
+	0x35, 0x66, 0x35, 0x2d, 0x34, 0x39, 0x62, 0x61, 0x61, 0x32, 0x33, 0x62, 0x64, 0x30, 0x63, 0x39,
+	0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x91, 0x01, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x75, 0xfa, 0x42, 0x1e, 0x72, 0x1c, 0x52,
+	0x04, 0x48, 0x54, 0x54, 0x50, 0x52, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x52, 0x03, 0x54, 0x4c,
+	0x53, 0x52, 0x03, 0x54, 0x43, 0x50, 0x52, 0x03, 0x55, 0x44, 0x50, 0x92, 0x41, 0x51, 0x32, 0x26,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x06, 0x22, 0x48, 0x54, 0x54, 0x50, 0x22, 0xf2, 0x02,
+	0x04, 0x48, 0x54, 0x54, 0x50, 0xf2, 0x02, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0xf2, 0x02, 0x03,
+	0x54, 0x4c, 0x53, 0xf2, 0x02, 0x03, 0x54, 0x43, 0x50, 0xf2, 0x02, 0x03, 0x55, 0x44, 0x50, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x61, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xfa, 0x42, 0x09,
+	0x72, 0x07, 0x10, 0x01, 0x18, 0xfd, 0x01, 0x68, 0x01, 0x92, 0x41, 0x36, 0x32, 0x21, 0x48, 0x6f,
+	0x73, 0x74, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a,
+	0x11, 0x22, 0x77, 0x77, 0x77, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f,
+	0x6d, 0x22, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x67, 0x0a, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x45, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x92, 0x41, 0x3b, 0x32, 0x11, 0x54,
+	0x68, 0x65, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x64,
+	0x4a, 0x26, 0x22, 0x34, 0x36, 0x31, 0x65, 0x62, 0x61, 0x62, 0x63, 0x2d, 0x37, 0x35, 0x37, 0x61,
+	0x2d, 0x34, 0x31, 0x62, 0x65, 0x2d, 0x61, 0x31, 0x35, 0x64, 0x2d, 0x38, 0x39, 0x61, 0x66, 0x62,
+	0x65, 0x65, 0x34, 0x30, 0x37, 0x63, 0x39, 0x22, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x80, 0x01, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x46, 0x92, 0x41, 0x43, 0x32, 0x29, 0x54, 0x68, 0x65, 0x20,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x16, 0x22, 0x31, 0x39, 0x37, 0x30, 0x2d, 0x30, 0x31, 0x2d,
+	0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x76, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x32, 0x1f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x16, 0x22,
+	0x31, 0x39, 0x37, 0x30, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30,
+	0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x80, 0x01, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x46, 0x92, 0x41, 0x43, 0x32, 0x29, 0x54, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4a, 0x16, 0x22, 0x31, 0x39, 0x37, 0x30, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30,
+	0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xa6, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xa8,
+	0x01, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x8d, 0x01, 0xfa, 0x42, 0x29, 0x72, 0x27, 0x52, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x52, 0x04,
+	0x50, 0x49, 0x4e, 0x47, 0x52, 0x04, 0x50, 0x4f, 0x4e, 0x47, 0x52, 0x04, 0x50, 0x55, 0x53, 0x48,
+	0x52, 0x06, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x52, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x92,
+	0x41, 0x5e, 0x32, 0x27, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x20, 0x75, 0x73, 0x65,
+	0x64, 0x20, 0x69, 0x6e, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x06, 0x22, 0x49, 0x4e,
+	0x49, 0x54, 0x22, 0xf2, 0x02, 0x04, 0x49, 0x4e, 0x49, 0x54, 0xf2, 0x02, 0x04, 0x50, 0x49, 0x4e,
+	0x47, 0xf2, 0x02, 0x04, 0x50, 0x4f, 0x4e, 0x47, 0xf2, 0x02, 0x04, 0x50, 0x55, 0x53, 0x48, 0xf2,
+	0x02, 0x06, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0xf2, 0x02, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x61, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32,
+	0x1a, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x26, 0x22, 0x30, 0x45,
+	0x35, 0x39, 0x39, 0x30, 0x38, 0x36, 0x2d, 0x38, 0x33, 0x30, 0x31, 0x2d, 0x34, 0x38, 0x42, 0x30,
+	0x2d, 0x38, 0x37, 0x30, 0x33, 0x2d, 0x34, 0x44, 0x31, 0x42, 0x36, 0x46, 0x32, 0x32, 0x46, 0x32,
+	0x39, 0x35, 0x22, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xa7, 0x02, 0x0a,
+	0x17, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8d, 0x01, 0xfa, 0x42, 0x29,
+	0x72, 0x27, 0x52, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x52, 0x04, 0x50, 0x49, 0x4e, 0x47, 0x52, 0x04,
+	0x50, 0x4f, 0x4e, 0x47, 0x52, 0x04, 0x50, 0x55, 0x53, 0x48, 0x52, 0x06, 0x46, 0x49, 0x4e, 0x49,
+	0x53, 0x48, 0x52, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x92, 0x41, 0x5e, 0x32, 0x27, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x06, 0x22, 0x50, 0x49, 0x4e, 0x47, 0x22, 0xf2, 0x02, 0x04,
+	0x49, 0x4e, 0x49, 0x54, 0xf2, 0x02, 0x04, 0x50, 0x49, 0x4e, 0x47, 0xf2, 0x02, 0x04, 0x50, 0x4f,
+	0x4e, 0x47, 0xf2, 0x02, 0x04, 0x50, 0x55, 0x53, 0x48, 0xf2, 0x02, 0x06, 0x46, 0x49, 0x4e, 0x49,
+	0x53, 0x48, 0xf2, 0x02, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x61, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x1a, 0x54, 0x68, 0x65, 0x20, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x26, 0x22, 0x30, 0x45, 0x35, 0x39, 0x39, 0x30, 0x38, 0x36,
+	0x2d, 0x38, 0x33, 0x30, 0x31, 0x2d, 0x34, 0x38, 0x42, 0x30, 0x2d, 0x38, 0x37, 0x30, 0x33, 0x2d,
+	0x34, 0x44, 0x31, 0x42, 0x36, 0x46, 0x32, 0x32, 0x46, 0x32, 0x39, 0x35, 0x22, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x32, 0xf3, 0x0a, 0x0a, 0x11, 0x42, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x70, 0x0a, 0x05,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x09,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x0f, 0x12,
+	0x0b, 0x55, 0x73, 0x65, 0x72, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x62, 0x00, 0x12, 0xa8,
+	0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x92, 0x41, 0x31, 0x12, 0x2f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x20, 0x73, 0x69, 0x64, 0x65, 0x30, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x28, 0x12, 0x26, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0xbe, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x92, 0x41, 0x2a, 0x12, 0x28, 0x47, 0x65, 0x74,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x70, 0x6f, 0x6f, 0x6c,
+	0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x2e, 0x12, 0xc4, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x3a, 0x01,
+	0x2a, 0x92, 0x41, 0x38, 0x12, 0x36, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x61, 0x6e, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x12, 0xb3, 0x01, 0x0a,
+	0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x27, 0x12, 0x25, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x2e, 0x12, 0x8d, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x18, 0x12, 0x16, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x12, 0xb4, 0x01, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x55, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x2a, 0x12,
+	0x28, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x1a, 0x21, 0x92, 0x41, 0x1e, 0x12, 0x1c,
+	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x32, 0x95, 0x03, 0x0a,
+	0x0e, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0xb2, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x73, 0x92, 0x41, 0x33, 0x12, 0x31, 0x57, 0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68,
-	0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x70, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x20, 0x73, 0x69, 0x64, 0x65, 0x30, 0x01, 0x12, 0xae, 0x01, 0x0a, 0x0f,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x28, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76,
-	0x31, 0x2f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41,
-	0x27, 0x12, 0x25, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x75,
-	0x73, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x64, 0x2e, 0x28, 0x01, 0x30, 0x01, 0x1a, 0x1d, 0x92, 0x41,
-	0x1a, 0x12, 0x18, 0x50, 0x65, 0x65, 0x72, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x96, 0x02, 0x5a, 0x30,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x70, 0x65, 0x6c,
-	0x69, 0x73, 0x6d, 0x69, 0x74, 0x68, 0x2f, 0x6b, 0x75, 0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31,
-	0x92, 0x41, 0xe0, 0x01, 0x12, 0x86, 0x01, 0x0a, 0x17, 0x4b, 0x75, 0x6e, 0x20, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x20, 0x41, 0x70, 0x69, 0x20, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x65, 0x41, 0x20, 0x66, 0x61, 0x73, 0x74, 0x20, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x20, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x68, 0x65, 0x6c, 0x70, 0x20, 0x79,
-	0x6f, 0x75, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x20, 0x68, 0x74, 0x74, 0x70, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x62, 0x65,
-	0x68, 0x69, 0x6e, 0x64, 0x20, 0x61, 0x20, 0x4e, 0x41, 0x54, 0x20, 0x6f, 0x72, 0x20, 0x66, 0x69,
-	0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x2e, 0x32, 0x04, 0x76, 0x31, 0x2e, 0x30, 0x2a, 0x02, 0x02,
-	0x01, 0x5a, 0x3c, 0x0a, 0x3a, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x08, 0x02, 0x12, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x0d,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62,
-	0x13, 0x0a, 0x11, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x92, 0x41, 0x33, 0x12,
+	0x31, 0x57, 0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20,
+	0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x73, 0x69,
+	0x64, 0x65, 0x30, 0x01, 0x12, 0xae, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x27, 0x12, 0x25, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x64,
+	0x2e, 0x28, 0x01, 0x30, 0x01, 0x1a, 0x1d, 0x92, 0x41, 0x1a, 0x12, 0x18, 0x50, 0x65, 0x65, 0x72,
+	0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x42, 0x96, 0x02, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x70, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x69, 0x74, 0x68, 0x2f,
+	0x6b, 0x75, 0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x92, 0x41, 0xe0, 0x01, 0x12, 0x86, 0x01,
+	0x0a, 0x17, 0x4b, 0x75, 0x6e, 0x20, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x41, 0x70, 0x69,
+	0x20, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x65, 0x41, 0x20, 0x66, 0x61, 0x73,
+	0x74, 0x20, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x20,
+	0x74, 0x6f, 0x20, 0x68, 0x65, 0x6c, 0x70, 0x20, 0x79, 0x6f, 0x75, 0x20, 0x65, 0x78, 0x70, 0x6f,
+	0x73, 0x65, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x20, 0x68, 0x74, 0x74, 0x70, 0x20,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x20, 0x61, 0x20,
+	0x4e, 0x41, 0x54, 0x20, 0x6f, 0x72, 0x20, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x20,
+	0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x2e,
+	0x32, 0x04, 0x76, 0x31, 0x2e, 0x30, 0x2a, 0x02, 0x02, 0x01, 0x5a, 0x3c, 0x0a, 0x3a, 0x0a, 0x0d,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x08,
+	0x02, 0x12, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62, 0x13, 0x0a, 0x11, 0x0a, 0x0d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x00, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -937,39 +2895,65 @@ func file_tunnel_proto_rawDescGZIP() []byte {
 	return file_tunnel_proto_rawDescData
 }
 
-var file_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_tunnel_proto_goTypes = []interface{}{
-	(*LoginRequest)(nil),            // 0: apiserver.api.v1.LoginRequest
-	(*LoginResponse)(nil),           // 1: apiserver.api.v1.LoginResponse
-	(*WatchTunnelsRequest)(nil),     // 2: apiserver.api.v1.WatchTunnelsRequest
-	(*WatchTunnelsResponse)(nil),    // 3: apiserver.api.v1.WatchTunnelsResponse
-	(*TunnelMessage)(nil),           // 4: apiserver.api.v1.TunnelMessage
-	(*WatchUpstreamsRequest)(nil),   // 5: apiserver.api.v1.WatchUpstreamsRequest
-	(*WatchUpstreamsResponse)(nil),  // 6: apiserver.api.v1.WatchUpstreamsResponse
-	(*ConnectUpstreamRequest)(nil),  // 7: apiserver.api.v1.ConnectUpstreamRequest
-	(*ConnectUpstreamResponse)(nil), // 8: apiserver.api.v1.ConnectUpstreamResponse
-	(*timestamppb.Timestamp)(nil),   // 9: google.protobuf.Timestamp
+	(*LoginRequest)(nil),              // 0: apiserver.api.v1.LoginRequest
+	(*LoginResponse)(nil),             // 1: apiserver.api.v1.LoginResponse
+	(*HeaderRule)(nil),                // 2: apiserver.api.v1.HeaderRule
+	(*WatchTunnelsRequest)(nil),       // 3: apiserver.api.v1.WatchTunnelsRequest
+	(*WatchTunnelsResponse)(nil),      // 4: apiserver.api.v1.WatchTunnelsResponse
+	(*GetUpstreamStatsRequest)(nil),   // 5: apiserver.api.v1.GetUpstreamStatsRequest
+	(*GetUpstreamStatsResponse)(nil),  // 6: apiserver.api.v1.GetUpstreamStatsResponse
+	(*ReportHealthRequest)(nil),       // 7: apiserver.api.v1.ReportHealthRequest
+	(*ReportHealthResponse)(nil),      // 8: apiserver.api.v1.ReportHealthResponse
+	(*TunnelMessage)(nil),             // 9: apiserver.api.v1.TunnelMessage
+	(*UploadCertificateRequest)(nil),  // 10: apiserver.api.v1.UploadCertificateRequest
+	(*UploadCertificateResponse)(nil), // 11: apiserver.api.v1.UploadCertificateResponse
+	(*ClaimDomainRequest)(nil),        // 12: apiserver.api.v1.ClaimDomainRequest
+	(*ClaimDomainResponse)(nil),       // 13: apiserver.api.v1.ClaimDomainResponse
+	(*VerifyDomainRequest)(nil),       // 14: apiserver.api.v1.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),      // 15: apiserver.api.v1.VerifyDomainResponse
+	(*WatchUpstreamsRequest)(nil),     // 16: apiserver.api.v1.WatchUpstreamsRequest
+	(*WatchUpstreamsResponse)(nil),    // 17: apiserver.api.v1.WatchUpstreamsResponse
+	(*ConnectUpstreamRequest)(nil),    // 18: apiserver.api.v1.ConnectUpstreamRequest
+	(*ConnectUpstreamResponse)(nil),   // 19: apiserver.api.v1.ConnectUpstreamResponse
+	(*durationpb.Duration)(nil),       // 20: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),     // 21: google.protobuf.Timestamp
 }
 var file_tunnel_proto_depIdxs = []int32{
-	9, // 0: apiserver.api.v1.WatchUpstreamsRequest.startedAt:type_name -> google.protobuf.Timestamp
-	9, // 1: apiserver.api.v1.WatchUpstreamsResponse.createdAt:type_name -> google.protobuf.Timestamp
-	9, // 2: apiserver.api.v1.WatchUpstreamsResponse.updatedAt:type_name -> google.protobuf.Timestamp
-	9, // 3: apiserver.api.v1.WatchUpstreamsResponse.deletedAt:type_name -> google.protobuf.Timestamp
-	0, // 4: apiserver.api.v1.BackendController.Login:input_type -> apiserver.api.v1.LoginRequest
-	2, // 5: apiserver.api.v1.BackendController.WatchTunnels:input_type -> apiserver.api.v1.WatchTunnelsRequest
-	4, // 6: apiserver.api.v1.BackendController.ConnectTunnel:input_type -> apiserver.api.v1.TunnelMessage
-	5, // 7: apiserver.api.v1.PeerController.WatchUpstreams:input_type -> apiserver.api.v1.WatchUpstreamsRequest
-	7, // 8: apiserver.api.v1.PeerController.ConnectUpstream:input_type -> apiserver.api.v1.ConnectUpstreamRequest
-	1, // 9: apiserver.api.v1.BackendController.Login:output_type -> apiserver.api.v1.LoginResponse
-	3, // 10: apiserver.api.v1.BackendController.WatchTunnels:output_type -> apiserver.api.v1.WatchTunnelsResponse
-	4, // 11: apiserver.api.v1.BackendController.ConnectTunnel:output_type -> apiserver.api.v1.TunnelMessage
-	6, // 12: apiserver.api.v1.PeerController.WatchUpstreams:output_type -> apiserver.api.v1.WatchUpstreamsResponse
-	8, // 13: apiserver.api.v1.PeerController.ConnectUpstream:output_type -> apiserver.api.v1.ConnectUpstreamResponse
-	9, // [9:14] is the sub-list for method output_type
-	4, // [4:9] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2,  // 0: apiserver.api.v1.WatchTunnelsRequest.requestHeaders:type_name -> apiserver.api.v1.HeaderRule
+	2,  // 1: apiserver.api.v1.WatchTunnelsRequest.responseHeaders:type_name -> apiserver.api.v1.HeaderRule
+	20, // 2: apiserver.api.v1.GetUpstreamStatsResponse.acquireWaitAvg:type_name -> google.protobuf.Duration
+	20, // 3: apiserver.api.v1.GetUpstreamStatsResponse.acquireWaitMax:type_name -> google.protobuf.Duration
+	21, // 4: apiserver.api.v1.WatchUpstreamsRequest.startedAt:type_name -> google.protobuf.Timestamp
+	21, // 5: apiserver.api.v1.WatchUpstreamsResponse.createdAt:type_name -> google.protobuf.Timestamp
+	21, // 6: apiserver.api.v1.WatchUpstreamsResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	21, // 7: apiserver.api.v1.WatchUpstreamsResponse.deletedAt:type_name -> google.protobuf.Timestamp
+	0,  // 8: apiserver.api.v1.BackendController.Login:input_type -> apiserver.api.v1.LoginRequest
+	3,  // 9: apiserver.api.v1.BackendController.WatchTunnels:input_type -> apiserver.api.v1.WatchTunnelsRequest
+	9,  // 10: apiserver.api.v1.BackendController.ConnectTunnel:input_type -> apiserver.api.v1.TunnelMessage
+	5,  // 11: apiserver.api.v1.BackendController.GetUpstreamStats:input_type -> apiserver.api.v1.GetUpstreamStatsRequest
+	7,  // 12: apiserver.api.v1.BackendController.ReportHealth:input_type -> apiserver.api.v1.ReportHealthRequest
+	10, // 13: apiserver.api.v1.BackendController.UploadCertificate:input_type -> apiserver.api.v1.UploadCertificateRequest
+	12, // 14: apiserver.api.v1.BackendController.ClaimDomain:input_type -> apiserver.api.v1.ClaimDomainRequest
+	14, // 15: apiserver.api.v1.BackendController.VerifyDomain:input_type -> apiserver.api.v1.VerifyDomainRequest
+	16, // 16: apiserver.api.v1.PeerController.WatchUpstreams:input_type -> apiserver.api.v1.WatchUpstreamsRequest
+	18, // 17: apiserver.api.v1.PeerController.ConnectUpstream:input_type -> apiserver.api.v1.ConnectUpstreamRequest
+	1,  // 18: apiserver.api.v1.BackendController.Login:output_type -> apiserver.api.v1.LoginResponse
+	4,  // 19: apiserver.api.v1.BackendController.WatchTunnels:output_type -> apiserver.api.v1.WatchTunnelsResponse
+	9,  // 20: apiserver.api.v1.BackendController.ConnectTunnel:output_type -> apiserver.api.v1.TunnelMessage
+	6,  // 21: apiserver.api.v1.BackendController.GetUpstreamStats:output_type -> apiserver.api.v1.GetUpstreamStatsResponse
+	8,  // 22: apiserver.api.v1.BackendController.ReportHealth:output_type -> apiserver.api.v1.ReportHealthResponse
+	11, // 23: apiserver.api.v1.BackendController.UploadCertificate:output_type -> apiserver.api.v1.UploadCertificateResponse
+	13, // 24: apiserver.api.v1.BackendController.ClaimDomain:output_type -> apiserver.api.v1.ClaimDomainResponse
+	15, // 25: apiserver.api.v1.BackendController.VerifyDomain:output_type -> apiserver.api.v1.VerifyDomainResponse
+	17, // 26: apiserver.api.v1.PeerController.WatchUpstreams:output_type -> apiserver.api.v1.WatchUpstreamsResponse
+	19, // 27: apiserver.api.v1.PeerController.ConnectUpstream:output_type -> apiserver.api.v1.ConnectUpstreamResponse
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_tunnel_proto_init() }
@@ -1003,7 +2987,7 @@ func file_tunnel_proto_init() {
 			}
 		}
 		file_tunnel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchTunnelsRequest); i {
+			switch v := v.(*HeaderRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1015,7 +2999,7 @@ func file_tunnel_proto_init() {
 			}
 		}
 		file_tunnel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchTunnelsResponse); i {
+			switch v := v.(*WatchTunnelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1027,7 +3011,7 @@ func file_tunnel_proto_init() {
 			}
 		}
 		file_tunnel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelMessage); i {
+			switch v := v.(*WatchTunnelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1039,7 +3023,7 @@ func file_tunnel_proto_init() {
 			}
 		}
 		file_tunnel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchUpstreamsRequest); i {
+			switch v := v.(*GetUpstreamStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1051,7 +3035,7 @@ func file_tunnel_proto_init() {
 			}
 		}
 		file_tunnel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchUpstreamsResponse); i {
+			switch v := v.(*GetUpstreamStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1063,7 +3047,7 @@ func file_tunnel_proto_init() {
 			}
 		}
 		file_tunnel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectUpstreamRequest); i {
+			switch v := v.(*ReportHealthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1075,6 +3059,138 @@ func file_tunnel_proto_init() {
 			}
 		}
 		file_tunnel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCertificateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimDomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimDomainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDomainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchUpstreamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchUpstreamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectUpstreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectUpstreamResponse); i {
 			case 0:
 				return &v.state
@@ -1093,7 +3209,7 @@ func file_tunnel_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tunnel_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
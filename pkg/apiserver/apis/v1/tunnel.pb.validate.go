@@ -336,22 +336,1935 @@ var _ interface {
 	ErrorName() string
 } = LoginResponseValidationError{}
 
+// Validate checks the field values on HeaderRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HeaderRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HeaderRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HeaderRuleMultiError, or
+// nil if none found.
+func (m *HeaderRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HeaderRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _HeaderRule_Action_InLookup[m.GetAction()]; !ok {
+		err := HeaderRuleValidationError{
+			field:  "Action",
+			reason: "value must be in list [ADD SET REMOVE REPLACE]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := HeaderRuleValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_HeaderRule_Name_Pattern.MatchString(m.GetName()) {
+		err := HeaderRuleValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[!#$%&'*+.^_`|~0-9A-Za-z-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetValue()) > 4096 {
+		err := HeaderRuleValidationError{
+			field:  "Value",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPattern()) > 256 {
+		err := HeaderRuleValidationError{
+			field:  "Pattern",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return HeaderRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeaderRuleMultiError is an error wrapping multiple validation errors
+// returned by HeaderRule.ValidateAll() if the designated constraints aren't met.
+type HeaderRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeaderRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeaderRuleMultiError) AllErrors() []error { return m }
+
+// HeaderRuleValidationError is the validation error returned by
+// HeaderRule.Validate if the designated constraints aren't met.
+type HeaderRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeaderRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeaderRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeaderRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeaderRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeaderRuleValidationError) ErrorName() string { return "HeaderRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeaderRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeaderRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeaderRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeaderRuleValidationError{}
+
+var _HeaderRule_Action_InLookup = map[string]struct{}{
+	"ADD":     {},
+	"SET":     {},
+	"REMOVE":  {},
+	"REPLACE": {},
+}
+
+var _HeaderRule_Name_Pattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
 // Validate checks the field values on WatchTunnelsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchTunnelsRequest) Validate() error {
+func (m *WatchTunnelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchTunnelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchTunnelsRequestMultiError, or nil if none found.
+func (m *WatchTunnelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchTunnelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetHostname() != "" {
+
+		if utf8.RuneCountInString(m.GetHostname()) > 253 {
+			err := WatchTunnelsRequestValidationError{
+				field:  "Hostname",
+				reason: "value length must be at most 253 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_WatchTunnelsRequest_Hostname_Pattern.MatchString(m.GetHostname()) {
+			err := WatchTunnelsRequestValidationError{
+				field:  "Hostname",
+				reason: "value does not match regex pattern \"^(\\\\*\\\\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _WatchTunnelsRequest_Protocol_InLookup[m.GetProtocol()]; !ok {
+		err := WatchTunnelsRequestValidationError{
+			field:  "Protocol",
+			reason: "value must be in list [HTTP HTTPS TLS TCP UDP]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPoolSize(); val < 0 || val > 1024 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "PoolSize",
+			reason: "value must be inside range [0, 1024]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPort(); val < 0 || val > 65535 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "Port",
+			reason: "value must be inside range [0, 65535]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Http2
+
+	// no validation rules for Multiplex
+
+	_WatchTunnelsRequest_Compression_Unique := make(map[string]struct{}, len(m.GetCompression()))
+
+	for idx, item := range m.GetCompression() {
+		_, _ = idx, item
+
+		if _, exists := _WatchTunnelsRequest_Compression_Unique[item]; exists {
+			err := WatchTunnelsRequestValidationError{
+				field:  fmt.Sprintf("Compression[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_WatchTunnelsRequest_Compression_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _WatchTunnelsRequest_Compression_InLookup[item]; !ok {
+			err := WatchTunnelsRequestValidationError{
+				field:  fmt.Sprintf("Compression[%v]", idx),
+				reason: "value must be in list [gzip zstd snappy]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetResumeId() != "" {
+
+		if err := m._validateUuid(m.GetResumeId()); err != nil {
+			err = WatchTunnelsRequestValidationError{
+				field:  "ResumeId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetMinPoolSize(); val < 0 || val > 1024 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "MinPoolSize",
+			reason: "value must be inside range [0, 1024]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxPoolSize(); val < 0 || val > 1024 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "MaxPoolSize",
+			reason: "value must be inside range [0, 1024]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _WatchTunnelsRequest_LoadBalancing_InLookup[m.GetLoadBalancing()]; !ok {
+		err := WatchTunnelsRequestValidationError{
+			field:  "LoadBalancing",
+			reason: "value must be in list [ ROUND_ROBIN LEAST_CONNECTIONS WEIGHTED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetWeight(); val < 0 || val > 100 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "Weight",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPathPrefix() != "" {
+
+		if utf8.RuneCountInString(m.GetPathPrefix()) > 256 {
+			err := WatchTunnelsRequestValidationError{
+				field:  "PathPrefix",
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_WatchTunnelsRequest_PathPrefix_Pattern.MatchString(m.GetPathPrefix()) {
+			err := WatchTunnelsRequestValidationError{
+				field:  "PathPrefix",
+				reason: "value does not match regex pattern \"^(/[A-Za-z0-9._~-]+)*/?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for StripPrefix
+
+	if m.GetRewritePrefix() != "" {
+
+		if utf8.RuneCountInString(m.GetRewritePrefix()) > 256 {
+			err := WatchTunnelsRequestValidationError{
+				field:  "RewritePrefix",
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_WatchTunnelsRequest_RewritePrefix_Pattern.MatchString(m.GetRewritePrefix()) {
+			err := WatchTunnelsRequestValidationError{
+				field:  "RewritePrefix",
+				reason: "value does not match regex pattern \"^(/[A-Za-z0-9._~-]+)*/?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetRequestHeaders()) > 64 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "RequestHeaders",
+			reason: "value must contain no more than 64 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRequestHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchTunnelsRequestValidationError{
+						field:  fmt.Sprintf("RequestHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchTunnelsRequestValidationError{
+						field:  fmt.Sprintf("RequestHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchTunnelsRequestValidationError{
+					field:  fmt.Sprintf("RequestHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetResponseHeaders()) > 64 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "ResponseHeaders",
+			reason: "value must contain no more than 64 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetResponseHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchTunnelsRequestValidationError{
+						field:  fmt.Sprintf("ResponseHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchTunnelsRequestValidationError{
+						field:  fmt.Sprintf("ResponseHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchTunnelsRequestValidationError{
+					field:  fmt.Sprintf("ResponseHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetAllowCidrs()) > 256 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "AllowCidrs",
+			reason: "value must contain no more than 256 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAllowCidrs() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := WatchTunnelsRequestValidationError{
+				field:  fmt.Sprintf("AllowCidrs[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetDenyCidrs()) > 256 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "DenyCidrs",
+			reason: "value must contain no more than 256 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDenyCidrs() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := WatchTunnelsRequestValidationError{
+				field:  fmt.Sprintf("DenyCidrs[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetBasicAuth()) > 64 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "BasicAuth",
+			reason: "value must contain no more than 64 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBasicAuth() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) > 256 {
+			err := WatchTunnelsRequestValidationError{
+				field:  fmt.Sprintf("BasicAuth[%v]", idx),
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_WatchTunnelsRequest_BasicAuth_Pattern.MatchString(item) {
+			err := WatchTunnelsRequestValidationError{
+				field:  fmt.Sprintf("BasicAuth[%v]", idx),
+				reason: "value does not match regex pattern \"^[^:]+:.+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetBearerTokens()) > 64 {
+		err := WatchTunnelsRequestValidationError{
+			field:  "BearerTokens",
+			reason: "value must contain no more than 64 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBearerTokens() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) > 256 {
+			err := WatchTunnelsRequestValidationError{
+				field:  fmt.Sprintf("BearerTokens[%v]", idx),
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_WatchTunnelsRequest_BearerTokens_Pattern.MatchString(item) {
+			err := WatchTunnelsRequestValidationError{
+				field:  fmt.Sprintf("BearerTokens[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z0-9._~+/-]+=*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetWindowSize() != 0 {
+
+		if m.GetWindowSize() < 65536 {
+			err := WatchTunnelsRequestValidationError{
+				field:  "WindowSize",
+				reason: "value must be greater than or equal to 65536",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchTunnelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WatchTunnelsRequest) _validateUuid(uuid string) error {
+	if matched := _tunnel_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchTunnelsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchTunnelsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchTunnelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchTunnelsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchTunnelsRequestMultiError) AllErrors() []error { return m }
+
+// WatchTunnelsRequestValidationError is the validation error returned by
+// WatchTunnelsRequest.Validate if the designated constraints aren't met.
+type WatchTunnelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchTunnelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchTunnelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchTunnelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchTunnelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchTunnelsRequestValidationError) ErrorName() string {
+	return "WatchTunnelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchTunnelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchTunnelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchTunnelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchTunnelsRequestValidationError{}
+
+var _WatchTunnelsRequest_Hostname_Pattern = regexp.MustCompile("^(\\*\\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.?$")
+
+var _WatchTunnelsRequest_Protocol_InLookup = map[string]struct{}{
+	"HTTP":  {},
+	"HTTPS": {},
+	"TLS":   {},
+	"TCP":   {},
+	"UDP":   {},
+}
+
+var _WatchTunnelsRequest_Compression_InLookup = map[string]struct{}{
+	"gzip":   {},
+	"zstd":   {},
+	"snappy": {},
+}
+
+var _WatchTunnelsRequest_LoadBalancing_InLookup = map[string]struct{}{
+	"":                  {},
+	"ROUND_ROBIN":       {},
+	"LEAST_CONNECTIONS": {},
+	"WEIGHTED":          {},
+}
+
+var _WatchTunnelsRequest_PathPrefix_Pattern = regexp.MustCompile("^(/[A-Za-z0-9._~-]+)*/?$")
+
+var _WatchTunnelsRequest_RewritePrefix_Pattern = regexp.MustCompile("^(/[A-Za-z0-9._~-]+)*/?$")
+
+var _WatchTunnelsRequest_BasicAuth_Pattern = regexp.MustCompile("^[^:]+:.+$")
+
+var _WatchTunnelsRequest_BearerTokens_Pattern = regexp.MustCompile("^[A-Za-z0-9._~+/-]+=*$")
+
+// Validate checks the field values on WatchTunnelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchTunnelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchTunnelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchTunnelsResponseMultiError, or nil if none found.
+func (m *WatchTunnelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchTunnelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTraceId()); err != nil {
+		err = WatchTunnelsResponseValidationError{
+			field:  "TraceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTunnelToken()) < 1 {
+		err := WatchTunnelsResponseValidationError{
+			field:  "TunnelToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Port
+
+	// no validation rules for Compression
+
+	// no validation rules for UpstreamId
+
+	// no validation rules for Hostname
+
+	// no validation rules for WindowSize
+
+	if len(errors) > 0 {
+		return WatchTunnelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WatchTunnelsResponse) _validateUuid(uuid string) error {
+	if matched := _tunnel_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchTunnelsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchTunnelsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchTunnelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchTunnelsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchTunnelsResponseMultiError) AllErrors() []error { return m }
+
+// WatchTunnelsResponseValidationError is the validation error returned by
+// WatchTunnelsResponse.Validate if the designated constraints aren't met.
+type WatchTunnelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchTunnelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchTunnelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchTunnelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchTunnelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchTunnelsResponseValidationError) ErrorName() string {
+	return "WatchTunnelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchTunnelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchTunnelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchTunnelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchTunnelsResponseValidationError{}
+
+// Validate checks the field values on GetUpstreamStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUpstreamStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUpstreamStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUpstreamStatsRequestMultiError, or nil if none found.
+func (m *GetUpstreamStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUpstreamStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetHostname()); l < 1 || l > 253 {
+		err := GetUpstreamStatsRequestValidationError{
+			field:  "Hostname",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetUpstreamStatsRequest_Hostname_Pattern.MatchString(m.GetHostname()) {
+		err := GetUpstreamStatsRequestValidationError{
+			field:  "Hostname",
+			reason: "value does not match regex pattern \"^(\\\\*\\\\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpstreamId() != "" {
+
+		if err := m._validateUuid(m.GetUpstreamId()); err != nil {
+			err = GetUpstreamStatsRequestValidationError{
+				field:  "UpstreamId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUpstreamStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUpstreamStatsRequest) _validateUuid(uuid string) error {
+	if matched := _tunnel_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUpstreamStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUpstreamStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUpstreamStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUpstreamStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUpstreamStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetUpstreamStatsRequestValidationError is the validation error returned by
+// GetUpstreamStatsRequest.Validate if the designated constraints aren't met.
+type GetUpstreamStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUpstreamStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUpstreamStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUpstreamStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUpstreamStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUpstreamStatsRequestValidationError) ErrorName() string {
+	return "GetUpstreamStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUpstreamStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUpstreamStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUpstreamStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUpstreamStatsRequestValidationError{}
+
+var _GetUpstreamStatsRequest_Hostname_Pattern = regexp.MustCompile("^(\\*\\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.?$")
+
+// Validate checks the field values on GetUpstreamStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUpstreamStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUpstreamStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUpstreamStatsResponseMultiError, or nil if none found.
+func (m *GetUpstreamStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUpstreamStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UpstreamId
+
+	// no validation rules for Hostname
+
+	// no validation rules for PoolSize
+
+	// no validation rules for MinPoolSize
+
+	// no validation rules for MaxPoolSize
+
+	// no validation rules for Idle
+
+	// no validation rules for Active
+
+	// no validation rules for Pending
+
+	// no validation rules for Waiting
+
+	// no validation rules for Sessions
+
+	// no validation rules for PeakActive
+
+	// no validation rules for Acquired
+
+	// no validation rules for ScaleUps
+
+	// no validation rules for ScaleDowns
+
+	if all {
+		switch v := interface{}(m.GetAcquireWaitAvg()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUpstreamStatsResponseValidationError{
+					field:  "AcquireWaitAvg",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUpstreamStatsResponseValidationError{
+					field:  "AcquireWaitAvg",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcquireWaitAvg()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUpstreamStatsResponseValidationError{
+				field:  "AcquireWaitAvg",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAcquireWaitMax()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUpstreamStatsResponseValidationError{
+					field:  "AcquireWaitMax",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUpstreamStatsResponseValidationError{
+					field:  "AcquireWaitMax",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcquireWaitMax()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUpstreamStatsResponseValidationError{
+				field:  "AcquireWaitMax",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LoadBalancing
+
+	// no validation rules for Weight
+
+	// no validation rules for PathPrefix
+
+	if len(errors) > 0 {
+		return GetUpstreamStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUpstreamStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUpstreamStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUpstreamStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUpstreamStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUpstreamStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetUpstreamStatsResponseValidationError is the validation error returned by
+// GetUpstreamStatsResponse.Validate if the designated constraints aren't met.
+type GetUpstreamStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUpstreamStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUpstreamStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUpstreamStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUpstreamStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUpstreamStatsResponseValidationError) ErrorName() string {
+	return "GetUpstreamStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUpstreamStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUpstreamStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUpstreamStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUpstreamStatsResponseValidationError{}
+
+// Validate checks the field values on ReportHealthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportHealthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportHealthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportHealthRequestMultiError, or nil if none found.
+func (m *ReportHealthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportHealthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetHostname()); l < 1 || l > 253 {
+		err := ReportHealthRequestValidationError{
+			field:  "Hostname",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ReportHealthRequest_Hostname_Pattern.MatchString(m.GetHostname()) {
+		err := ReportHealthRequestValidationError{
+			field:  "Hostname",
+			reason: "value does not match regex pattern \"^(\\\\*\\\\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUpstreamId()); err != nil {
+		err = ReportHealthRequestValidationError{
+			field:  "UpstreamId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Healthy
+
+	if utf8.RuneCountInString(m.GetReason()) > 256 {
+		err := ReportHealthRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReportHealthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReportHealthRequest) _validateUuid(uuid string) error {
+	if matched := _tunnel_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReportHealthRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportHealthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportHealthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportHealthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportHealthRequestMultiError) AllErrors() []error { return m }
+
+// ReportHealthRequestValidationError is the validation error returned by
+// ReportHealthRequest.Validate if the designated constraints aren't met.
+type ReportHealthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportHealthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportHealthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportHealthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportHealthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportHealthRequestValidationError) ErrorName() string {
+	return "ReportHealthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportHealthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportHealthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportHealthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportHealthRequestValidationError{}
+
+var _ReportHealthRequest_Hostname_Pattern = regexp.MustCompile("^(\\*\\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.?$")
+
+// Validate checks the field values on ReportHealthResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportHealthResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportHealthResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportHealthResponseMultiError, or nil if none found.
+func (m *ReportHealthResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportHealthResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return ReportHealthResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportHealthResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportHealthResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReportHealthResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportHealthResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportHealthResponseMultiError) AllErrors() []error { return m }
+
+// ReportHealthResponseValidationError is the validation error returned by
+// ReportHealthResponse.Validate if the designated constraints aren't met.
+type ReportHealthResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportHealthResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportHealthResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportHealthResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportHealthResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportHealthResponseValidationError) ErrorName() string {
+	return "ReportHealthResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportHealthResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportHealthResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportHealthResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportHealthResponseValidationError{}
+
+// Validate checks the field values on TunnelMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TunnelMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TunnelMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TunnelMessageMultiError, or
+// nil if none found.
+func (m *TunnelMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TunnelMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _TunnelMessage_Command_InLookup[m.GetCommand()]; !ok {
+		err := TunnelMessageValidationError{
+			field:  "Command",
+			reason: "value must be in list [PING PONG PUSH FINISH RESET WINDOW]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Payload
+
+	// no validation rules for StreamId
+
+	// no validation rules for Window
+
+	if _, ok := _TunnelMessage_Encoding_InLookup[m.GetEncoding()]; !ok {
+		err := TunnelMessageValidationError{
+			field:  "Encoding",
+			reason: "value must be in list [ gzip zstd snappy]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TunnelMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// TunnelMessageMultiError is an error wrapping multiple validation errors
+// returned by TunnelMessage.ValidateAll() if the designated constraints
+// aren't met.
+type TunnelMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TunnelMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TunnelMessageMultiError) AllErrors() []error { return m }
+
+// TunnelMessageValidationError is the validation error returned by
+// TunnelMessage.Validate if the designated constraints aren't met.
+type TunnelMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TunnelMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TunnelMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TunnelMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TunnelMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TunnelMessageValidationError) ErrorName() string { return "TunnelMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TunnelMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTunnelMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TunnelMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TunnelMessageValidationError{}
+
+var _TunnelMessage_Command_InLookup = map[string]struct{}{
+	"PING":   {},
+	"PONG":   {},
+	"PUSH":   {},
+	"FINISH": {},
+	"RESET":  {},
+	"WINDOW": {},
+}
+
+var _TunnelMessage_Encoding_InLookup = map[string]struct{}{
+	"":       {},
+	"gzip":   {},
+	"zstd":   {},
+	"snappy": {},
+}
+
+// Validate checks the field values on UploadCertificateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadCertificateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadCertificateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadCertificateRequestMultiError, or nil if none found.
+func (m *UploadCertificateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadCertificateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetHostname()); l < 1 || l > 253 {
+		err := UploadCertificateRequestValidationError{
+			field:  "Hostname",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UploadCertificateRequest_Hostname_Pattern.MatchString(m.GetHostname()) {
+		err := UploadCertificateRequestValidationError{
+			field:  "Hostname",
+			reason: "value does not match regex pattern \"^(\\\\*\\\\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCertificate()) < 1 {
+		err := UploadCertificateRequestValidationError{
+			field:  "Certificate",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPrivateKey()) < 1 {
+		err := UploadCertificateRequestValidationError{
+			field:  "PrivateKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadCertificateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadCertificateRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadCertificateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadCertificateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadCertificateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadCertificateRequestMultiError) AllErrors() []error { return m }
+
+// UploadCertificateRequestValidationError is the validation error returned by
+// UploadCertificateRequest.Validate if the designated constraints aren't met.
+type UploadCertificateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadCertificateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadCertificateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadCertificateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadCertificateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadCertificateRequestValidationError) ErrorName() string {
+	return "UploadCertificateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadCertificateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadCertificateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadCertificateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadCertificateRequestValidationError{}
+
+var _UploadCertificateRequest_Hostname_Pattern = regexp.MustCompile("^(\\*\\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.?$")
+
+// Validate checks the field values on UploadCertificateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadCertificateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadCertificateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadCertificateResponseMultiError, or nil if none found.
+func (m *UploadCertificateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadCertificateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHostname()) < 1 {
+		err := UploadCertificateResponseValidationError{
+			field:  "Hostname",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetExpiredAt()) < 1 {
+		err := UploadCertificateResponseValidationError{
+			field:  "ExpiredAt",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadCertificateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadCertificateResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadCertificateResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UploadCertificateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadCertificateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadCertificateResponseMultiError) AllErrors() []error { return m }
+
+// UploadCertificateResponseValidationError is the validation error returned by
+// UploadCertificateResponse.Validate if the designated constraints aren't met.
+type UploadCertificateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadCertificateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadCertificateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadCertificateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadCertificateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadCertificateResponseValidationError) ErrorName() string {
+	return "UploadCertificateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadCertificateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadCertificateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadCertificateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadCertificateResponseValidationError{}
+
+// Validate checks the field values on ClaimDomainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClaimDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchTunnelsRequest with the rules
+// ValidateAll checks the field values on ClaimDomainRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchTunnelsRequestMultiError, or nil if none found.
-func (m *WatchTunnelsRequest) ValidateAll() error {
+// ClaimDomainRequestMultiError, or nil if none found.
+func (m *ClaimDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchTunnelsRequest) validate(all bool) error {
+func (m *ClaimDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -359,7 +2272,7 @@ func (m *WatchTunnelsRequest) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetHostname()); l < 1 || l > 253 {
-		err := WatchTunnelsRequestValidationError{
+		err := ClaimDomainRequestValidationError{
 			field:  "Hostname",
 			reason: "value length must be between 1 and 253 runes, inclusive",
 		}
@@ -369,11 +2282,10 @@ func (m *WatchTunnelsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateHostname(m.GetHostname()); err != nil {
-		err = WatchTunnelsRequestValidationError{
+	if !_ClaimDomainRequest_Hostname_Pattern.MatchString(m.GetHostname()) {
+		err := ClaimDomainRequestValidationError{
 			field:  "Hostname",
-			reason: "value must be a valid hostname",
-			cause:  err,
+			reason: "value does not match regex pattern \"^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.)+[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.?$\"",
 		}
 		if !all {
 			return err
@@ -381,72 +2293,136 @@ func (m *WatchTunnelsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _WatchTunnelsRequest_Protocol_InLookup[m.GetProtocol()]; !ok {
-		err := WatchTunnelsRequestValidationError{
-			field:  "Protocol",
-			reason: "value must be in list [HTTP HTTPS]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return ClaimDomainRequestMultiError(errors)
 	}
 
-	if m.GetPoolSize() < 0 {
-		err := WatchTunnelsRequestValidationError{
-			field:  "PoolSize",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// ClaimDomainRequestMultiError is an error wrapping multiple validation errors
+// returned by ClaimDomainRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClaimDomainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimDomainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if len(errors) > 0 {
-		return WatchTunnelsRequestMultiError(errors)
+// AllErrors returns a list of validation violation errors.
+func (m ClaimDomainRequestMultiError) AllErrors() []error { return m }
+
+// ClaimDomainRequestValidationError is the validation error returned by
+// ClaimDomainRequest.Validate if the designated constraints aren't met.
+type ClaimDomainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimDomainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimDomainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimDomainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimDomainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimDomainRequestValidationError) ErrorName() string {
+	return "ClaimDomainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClaimDomainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	return nil
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimDomainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
 }
 
-func (m *WatchTunnelsRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
+var _ error = ClaimDomainRequestValidationError{}
 
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimDomainRequestValidationError{}
+
+var _ClaimDomainRequest_Hostname_Pattern = regexp.MustCompile("^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.)+[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.?$")
+
+// Validate checks the field values on ClaimDomainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClaimDomainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimDomainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClaimDomainResponseMultiError, or nil if none found.
+func (m *ClaimDomainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimDomainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
+	var errors []error
 
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	// no validation rules for Hostname
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	// no validation rules for Status
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+	// no validation rules for TxtName
+
+	// no validation rules for TxtValue
+
+	// no validation rules for CnameTarget
+
+	// no validation rules for ExpiredAt
+
+	if len(errors) > 0 {
+		return ClaimDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchTunnelsRequestMultiError is an error wrapping multiple validation
-// errors returned by WatchTunnelsRequest.ValidateAll() if the designated
+// ClaimDomainResponseMultiError is an error wrapping multiple validation
+// errors returned by ClaimDomainResponse.ValidateAll() if the designated
 // constraints aren't met.
-type WatchTunnelsRequestMultiError []error
+type ClaimDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchTunnelsRequestMultiError) Error() string {
+func (m ClaimDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -455,11 +2431,11 @@ func (m WatchTunnelsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchTunnelsRequestMultiError) AllErrors() []error { return m }
+func (m ClaimDomainResponseMultiError) AllErrors() []error { return m }
 
-// WatchTunnelsRequestValidationError is the validation error returned by
-// WatchTunnelsRequest.Validate if the designated constraints aren't met.
-type WatchTunnelsRequestValidationError struct {
+// ClaimDomainResponseValidationError is the validation error returned by
+// ClaimDomainResponse.Validate if the designated constraints aren't met.
+type ClaimDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -467,24 +2443,24 @@ type WatchTunnelsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchTunnelsRequestValidationError) Field() string { return e.field }
+func (e ClaimDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchTunnelsRequestValidationError) Reason() string { return e.reason }
+func (e ClaimDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchTunnelsRequestValidationError) Cause() error { return e.cause }
+func (e ClaimDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchTunnelsRequestValidationError) Key() bool { return e.key }
+func (e ClaimDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchTunnelsRequestValidationError) ErrorName() string {
-	return "WatchTunnelsRequestValidationError"
+func (e ClaimDomainResponseValidationError) ErrorName() string {
+	return "ClaimDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchTunnelsRequestValidationError) Error() string {
+func (e ClaimDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -496,14 +2472,14 @@ func (e WatchTunnelsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchTunnelsRequest.%s: %s%s",
+		"invalid %sClaimDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchTunnelsRequestValidationError{}
+var _ error = ClaimDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -511,40 +2487,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchTunnelsRequestValidationError{}
-
-var _WatchTunnelsRequest_Protocol_InLookup = map[string]struct{}{
-	"HTTP":  {},
-	"HTTPS": {},
-}
+} = ClaimDomainResponseValidationError{}
 
-// Validate checks the field values on WatchTunnelsResponse with the rules
+// Validate checks the field values on VerifyDomainRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchTunnelsResponse) Validate() error {
+func (m *VerifyDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchTunnelsResponse with the rules
+// ValidateAll checks the field values on VerifyDomainRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchTunnelsResponseMultiError, or nil if none found.
-func (m *WatchTunnelsResponse) ValidateAll() error {
+// VerifyDomainRequestMultiError, or nil if none found.
+func (m *VerifyDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchTunnelsResponse) validate(all bool) error {
+func (m *VerifyDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetTraceId()); err != nil {
-		err = WatchTunnelsResponseValidationError{
-			field:  "TraceId",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if l := utf8.RuneCountInString(m.GetHostname()); l < 1 || l > 253 {
+		err := VerifyDomainRequestValidationError{
+			field:  "Hostname",
+			reason: "value length must be between 1 and 253 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -552,10 +2522,10 @@ func (m *WatchTunnelsResponse) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetTunnelToken()) < 1 {
-		err := WatchTunnelsResponseValidationError{
-			field:  "TunnelToken",
-			reason: "value length must be at least 1 runes",
+	if !_VerifyDomainRequest_Hostname_Pattern.MatchString(m.GetHostname()) {
+		err := VerifyDomainRequestValidationError{
+			field:  "Hostname",
+			reason: "value does not match regex pattern \"^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.)+[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\\\.?$\"",
 		}
 		if !all {
 			return err
@@ -564,27 +2534,19 @@ func (m *WatchTunnelsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WatchTunnelsResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *WatchTunnelsResponse) _validateUuid(uuid string) error {
-	if matched := _tunnel_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		return VerifyDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchTunnelsResponseMultiError is an error wrapping multiple validation
-// errors returned by WatchTunnelsResponse.ValidateAll() if the designated
+// VerifyDomainRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyDomainRequest.ValidateAll() if the designated
 // constraints aren't met.
-type WatchTunnelsResponseMultiError []error
+type VerifyDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchTunnelsResponseMultiError) Error() string {
+func (m VerifyDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -593,11 +2555,11 @@ func (m WatchTunnelsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchTunnelsResponseMultiError) AllErrors() []error { return m }
+func (m VerifyDomainRequestMultiError) AllErrors() []error { return m }
 
-// WatchTunnelsResponseValidationError is the validation error returned by
-// WatchTunnelsResponse.Validate if the designated constraints aren't met.
-type WatchTunnelsResponseValidationError struct {
+// VerifyDomainRequestValidationError is the validation error returned by
+// VerifyDomainRequest.Validate if the designated constraints aren't met.
+type VerifyDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -605,24 +2567,24 @@ type WatchTunnelsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchTunnelsResponseValidationError) Field() string { return e.field }
+func (e VerifyDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchTunnelsResponseValidationError) Reason() string { return e.reason }
+func (e VerifyDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchTunnelsResponseValidationError) Cause() error { return e.cause }
+func (e VerifyDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchTunnelsResponseValidationError) Key() bool { return e.key }
+func (e VerifyDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchTunnelsResponseValidationError) ErrorName() string {
-	return "WatchTunnelsResponseValidationError"
+func (e VerifyDomainRequestValidationError) ErrorName() string {
+	return "VerifyDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchTunnelsResponseValidationError) Error() string {
+func (e VerifyDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -634,14 +2596,14 @@ func (e WatchTunnelsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchTunnelsResponse.%s: %s%s",
+		"invalid %sVerifyDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchTunnelsResponseValidationError{}
+var _ error = VerifyDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -649,57 +2611,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchTunnelsResponseValidationError{}
+} = VerifyDomainRequestValidationError{}
 
-// Validate checks the field values on TunnelMessage with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TunnelMessage) Validate() error {
+var _VerifyDomainRequest_Hostname_Pattern = regexp.MustCompile("^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.)+[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\\.?$")
+
+// Validate checks the field values on VerifyDomainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TunnelMessage with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TunnelMessageMultiError, or
-// nil if none found.
-func (m *TunnelMessage) ValidateAll() error {
+// ValidateAll checks the field values on VerifyDomainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyDomainResponseMultiError, or nil if none found.
+func (m *VerifyDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TunnelMessage) validate(all bool) error {
+func (m *VerifyDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if _, ok := _TunnelMessage_Command_InLookup[m.GetCommand()]; !ok {
-		err := TunnelMessageValidationError{
-			field:  "Command",
-			reason: "value must be in list [PING PONG PUSH FINISH RESET]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Hostname
 
-	// no validation rules for Payload
+	// no validation rules for Status
+
+	// no validation rules for Method
+
+	// no validation rules for VerifiedAt
 
 	if len(errors) > 0 {
-		return TunnelMessageMultiError(errors)
+		return VerifyDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TunnelMessageMultiError is an error wrapping multiple validation errors
-// returned by TunnelMessage.ValidateAll() if the designated constraints
-// aren't met.
-type TunnelMessageMultiError []error
+// VerifyDomainResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyDomainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TunnelMessageMultiError) Error() string {
+func (m VerifyDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -708,11 +2667,11 @@ func (m TunnelMessageMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TunnelMessageMultiError) AllErrors() []error { return m }
+func (m VerifyDomainResponseMultiError) AllErrors() []error { return m }
 
-// TunnelMessageValidationError is the validation error returned by
-// TunnelMessage.Validate if the designated constraints aren't met.
-type TunnelMessageValidationError struct {
+// VerifyDomainResponseValidationError is the validation error returned by
+// VerifyDomainResponse.Validate if the designated constraints aren't met.
+type VerifyDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -720,22 +2679,24 @@ type TunnelMessageValidationError struct {
 }
 
 // Field function returns field value.
-func (e TunnelMessageValidationError) Field() string { return e.field }
+func (e VerifyDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TunnelMessageValidationError) Reason() string { return e.reason }
+func (e VerifyDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TunnelMessageValidationError) Cause() error { return e.cause }
+func (e VerifyDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TunnelMessageValidationError) Key() bool { return e.key }
+func (e VerifyDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TunnelMessageValidationError) ErrorName() string { return "TunnelMessageValidationError" }
+func (e VerifyDomainResponseValidationError) ErrorName() string {
+	return "VerifyDomainResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TunnelMessageValidationError) Error() string {
+func (e VerifyDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -747,14 +2708,14 @@ func (e TunnelMessageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTunnelMessage.%s: %s%s",
+		"invalid %sVerifyDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TunnelMessageValidationError{}
+var _ error = VerifyDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -762,15 +2723,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TunnelMessageValidationError{}
-
-var _TunnelMessage_Command_InLookup = map[string]struct{}{
-	"PING":   {},
-	"PONG":   {},
-	"PUSH":   {},
-	"FINISH": {},
-	"RESET":  {},
-}
+} = VerifyDomainResponseValidationError{}
 
 // Validate checks the field values on WatchUpstreamsRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -951,7 +2904,7 @@ func (m *WatchUpstreamsResponse) validate(all bool) error {
 	if _, ok := _WatchUpstreamsResponse_Protocol_InLookup[m.GetProtocol()]; !ok {
 		err := WatchUpstreamsResponseValidationError{
 			field:  "Protocol",
-			reason: "value must be in list [HTTP HTTPS]",
+			reason: "value must be in list [HTTP HTTPS TLS TCP UDP]",
 		}
 		if !all {
 			return err
@@ -1207,6 +3160,9 @@ var _WatchUpstreamsResponse_EventType_InLookup = map[string]struct{}{
 var _WatchUpstreamsResponse_Protocol_InLookup = map[string]struct{}{
 	"HTTP":  {},
 	"HTTPS": {},
+	"TLS":   {},
+	"TCP":   {},
+	"UDP":   {},
 }
 
 // Validate checks the field values on ConnectUpstreamRequest with the rules
@@ -27,6 +27,16 @@ type BackendControllerClient interface {
 	WatchTunnels(ctx context.Context, in *WatchTunnelsRequest, opts ...grpc.CallOption) (BackendController_WatchTunnelsClient, error)
 	// ConnectTunnel attempt to connect tunnel using token
 	ConnectTunnel(ctx context.Context, opts ...grpc.CallOption) (BackendController_ConnectTunnelClient, error)
+	// GetUpstreamStats returns the usage of the tunnel pool of a hostname watched by the caller
+	GetUpstreamStats(ctx context.Context, in *GetUpstreamStatsRequest, opts ...grpc.CallOption) (*GetUpstreamStatsResponse, error)
+	// ReportHealth updates the health of the local service of an upstream watched by the caller
+	ReportHealth(ctx context.Context, in *ReportHealthRequest, opts ...grpc.CallOption) (*ReportHealthResponse, error)
+	// UploadCertificate store the certificate of a hostname owned by the caller
+	UploadCertificate(ctx context.Context, in *UploadCertificateRequest, opts ...grpc.CallOption) (*UploadCertificateResponse, error)
+	// ClaimDomain starts the ownership verification of a custom domain by the caller
+	ClaimDomain(ctx context.Context, in *ClaimDomainRequest, opts ...grpc.CallOption) (*ClaimDomainResponse, error)
+	// VerifyDomain checks the DNS records proving the ownership of a custom domain claimed by the caller
+	VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error)
 }
 
 type backendControllerClient struct {
@@ -109,6 +119,51 @@ func (x *backendControllerConnectTunnelClient) Recv() (*TunnelMessage, error) {
 	return m, nil
 }
 
+func (c *backendControllerClient) GetUpstreamStats(ctx context.Context, in *GetUpstreamStatsRequest, opts ...grpc.CallOption) (*GetUpstreamStatsResponse, error) {
+	out := new(GetUpstreamStatsResponse)
+	err := c.cc.Invoke(ctx, "/apiserver.api.v1.BackendController/GetUpstreamStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendControllerClient) ReportHealth(ctx context.Context, in *ReportHealthRequest, opts ...grpc.CallOption) (*ReportHealthResponse, error) {
+	out := new(ReportHealthResponse)
+	err := c.cc.Invoke(ctx, "/apiserver.api.v1.BackendController/ReportHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendControllerClient) UploadCertificate(ctx context.Context, in *UploadCertificateRequest, opts ...grpc.CallOption) (*UploadCertificateResponse, error) {
+	out := new(UploadCertificateResponse)
+	err := c.cc.Invoke(ctx, "/apiserver.api.v1.BackendController/UploadCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendControllerClient) ClaimDomain(ctx context.Context, in *ClaimDomainRequest, opts ...grpc.CallOption) (*ClaimDomainResponse, error) {
+	out := new(ClaimDomainResponse)
+	err := c.cc.Invoke(ctx, "/apiserver.api.v1.BackendController/ClaimDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendControllerClient) VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error) {
+	out := new(VerifyDomainResponse)
+	err := c.cc.Invoke(ctx, "/apiserver.api.v1.BackendController/VerifyDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BackendControllerServer is the server API for BackendController service.
 // All implementations must embed UnimplementedBackendControllerServer
 // for forward compatibility
@@ -118,6 +173,16 @@ type BackendControllerServer interface {
 	WatchTunnels(*WatchTunnelsRequest, BackendController_WatchTunnelsServer) error
 	// ConnectTunnel attempt to connect tunnel using token
 	ConnectTunnel(BackendController_ConnectTunnelServer) error
+	// GetUpstreamStats returns the usage of the tunnel pool of a hostname watched by the caller
+	GetUpstreamStats(context.Context, *GetUpstreamStatsRequest) (*GetUpstreamStatsResponse, error)
+	// ReportHealth updates the health of the local service of an upstream watched by the caller
+	ReportHealth(context.Context, *ReportHealthRequest) (*ReportHealthResponse, error)
+	// UploadCertificate store the certificate of a hostname owned by the caller
+	UploadCertificate(context.Context, *UploadCertificateRequest) (*UploadCertificateResponse, error)
+	// ClaimDomain starts the ownership verification of a custom domain by the caller
+	ClaimDomain(context.Context, *ClaimDomainRequest) (*ClaimDomainResponse, error)
+	// VerifyDomain checks the DNS records proving the ownership of a custom domain claimed by the caller
+	VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error)
 	mustEmbedUnimplementedBackendControllerServer()
 }
 
@@ -134,6 +199,21 @@ func (UnimplementedBackendControllerServer) WatchTunnels(*WatchTunnelsRequest, B
 func (UnimplementedBackendControllerServer) ConnectTunnel(BackendController_ConnectTunnelServer) error {
 	return status.Errorf(codes.Unimplemented, "method ConnectTunnel not implemented")
 }
+func (UnimplementedBackendControllerServer) GetUpstreamStats(context.Context, *GetUpstreamStatsRequest) (*GetUpstreamStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpstreamStats not implemented")
+}
+func (UnimplementedBackendControllerServer) ReportHealth(context.Context, *ReportHealthRequest) (*ReportHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportHealth not implemented")
+}
+func (UnimplementedBackendControllerServer) UploadCertificate(context.Context, *UploadCertificateRequest) (*UploadCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadCertificate not implemented")
+}
+func (UnimplementedBackendControllerServer) ClaimDomain(context.Context, *ClaimDomainRequest) (*ClaimDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDomain not implemented")
+}
+func (UnimplementedBackendControllerServer) VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDomain not implemented")
+}
 func (UnimplementedBackendControllerServer) mustEmbedUnimplementedBackendControllerServer() {}
 
 // UnsafeBackendControllerServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +292,96 @@ func (x *backendControllerConnectTunnelServer) Recv() (*TunnelMessage, error) {
 	return m, nil
 }
 
+func _BackendController_GetUpstreamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpstreamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendControllerServer).GetUpstreamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiserver.api.v1.BackendController/GetUpstreamStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendControllerServer).GetUpstreamStats(ctx, req.(*GetUpstreamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackendController_ReportHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendControllerServer).ReportHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiserver.api.v1.BackendController/ReportHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendControllerServer).ReportHealth(ctx, req.(*ReportHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackendController_UploadCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendControllerServer).UploadCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiserver.api.v1.BackendController/UploadCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendControllerServer).UploadCertificate(ctx, req.(*UploadCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackendController_ClaimDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendControllerServer).ClaimDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiserver.api.v1.BackendController/ClaimDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendControllerServer).ClaimDomain(ctx, req.(*ClaimDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackendController_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendControllerServer).VerifyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiserver.api.v1.BackendController/VerifyDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendControllerServer).VerifyDomain(ctx, req.(*VerifyDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BackendController_ServiceDesc is the grpc.ServiceDesc for BackendController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -223,6 +393,26 @@ var BackendController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _BackendController_Login_Handler,
 		},
+		{
+			MethodName: "GetUpstreamStats",
+			Handler:    _BackendController_GetUpstreamStats_Handler,
+		},
+		{
+			MethodName: "ReportHealth",
+			Handler:    _BackendController_ReportHealth_Handler,
+		},
+		{
+			MethodName: "UploadCertificate",
+			Handler:    _BackendController_UploadCertificate_Handler,
+		},
+		{
+			MethodName: "ClaimDomain",
+			Handler:    _BackendController_ClaimDomain_Handler,
+		},
+		{
+			MethodName: "VerifyDomain",
+			Handler:    _BackendController_VerifyDomain_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -17,12 +17,16 @@ import (
 	"fmt"
 	"github.com/aapelismith/kun/pkg/auth"
 	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
 	"github.com/aapelismith/kun/pkg/types"
 	"github.com/spf13/pflag"
 	"golang.org/x/exp/slices"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"net"
 	"net/http"
 	"os"
+	"regexp"
+	"strings"
 	"time"
 )
 
@@ -32,8 +36,20 @@ var (
 	// 'verify_if_given'	Ask clients for a certificate; allow even if there isn't one, but verify it if there is
 	// 'require_and_verify'	Require clients to present a valid certificate that is verified
 	certificateAuthMode = []string{"request", "require", "verify_if_given", "require_and_verify"}
+
+	// hostnamePattern the hostnames without wildcard
+	hostnamePattern = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.?$`)
+
+	// challengePrefixPattern a single DNS label, which may start with an underscore
+	challengePrefixPattern = regexp.MustCompile(`^_?[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+
+	// bearerTokenPattern the token68 syntax of the Bearer credentials
+	bearerTokenPattern = regexp.MustCompile(`^[A-Za-z0-9._~+/-]+=*$`)
 )
 
+// minSigningKeyLength the minimum length of HMAC-SHA256 keys
+const minSigningKeyLength = 32
+
 type GlobalOptions struct {
 	// CacheDefaultExpiration given default expiration duration, If the expiration duration
 	// is less than one the items in the cache never expire
@@ -207,20 +223,75 @@ type FrontendOptions struct {
 	// If zero, DefaultMaxHeaderBytes is used.
 	MaxHeaderBytes int `yaml:"max_header_bytes,omitempty" json:"max_header_bytes,omitempty"`
 
+	// HTTP2MaxConcurrentStreams the maximum number of concurrent streams of
+	// an HTTP/2 connection, served over tls with ALPN or over h2c with prior
+	// knowledge. ReadTimeout and WriteTimeout bound every HTTP/2 stream too,
+	// they are 0 by default for long-lived gRPC streams.
+	HTTP2MaxConcurrentStreams uint32 `yaml:"http2_max_concurrent_streams,omitempty" json:"http2_max_concurrent_streams,omitempty"`
+
 	// default public key of the frontend service
 	DefaultCertificateFile string `yaml:"default_certificate_file,omitempty" json:"default_certificate_file,omitempty"`
 
 	// default private key of the frontend service
 	DefaultCertificateKeyFile string `yaml:"default_certificate_key_file,omitempty" json:"default_certificate_key_file,omitempty"`
+
+	// CertificateDir the directory storing the certificates of the hostnames,
+	// uploaded certificates are kept in memory only if empty
+	CertificateDir string `yaml:"certificate_dir,omitempty" json:"certificate_dir,omitempty"`
+
+	// BaseDomain the domain a random subdomain of is allocated to the
+	// clients watching no hostname, they are refused if empty
+	BaseDomain string `yaml:"base_domain,omitempty" json:"base_domain,omitempty"`
+
+	// TrustedProxies the CIDR ranges, or single addresses, of the proxies in
+	// front of the frontend whose X-Forwarded-For header is trusted to tell
+	// the address of the client checked against the access policy of an
+	// upstream. The Forwarded and X-Forwarded-For headers of the other peers
+	// are not passed to the local services
+	TrustedProxies []string `yaml:"trusted_proxies,omitempty" json:"trusted_proxies,omitempty"`
+
+	// TCPBindHost the host the public ports of the TCP upstreams are bound on,
+	// all interfaces if empty
+	TCPBindHost string `yaml:"tcp_bind_host,omitempty" json:"tcp_bind_host,omitempty"`
+
+	// TCPPortMin the first public port allocated to the TCP upstreams,
+	// TCP upstreams are refused if both TCPPortMin and TCPPortMax are 0
+	TCPPortMin int `yaml:"tcp_port_min,omitempty" json:"tcp_port_min,omitempty"`
+
+	// TCPPortMax the last public port allocated to the TCP upstreams
+	TCPPortMax int `yaml:"tcp_port_max,omitempty" json:"tcp_port_max,omitempty"`
+
+	// UDPBindHost the host the public ports of the UDP upstreams are bound on,
+	// all interfaces if empty
+	UDPBindHost string `yaml:"udp_bind_host,omitempty" json:"udp_bind_host,omitempty"`
+
+	// UDPPortMin the first public port allocated to the UDP upstreams,
+	// UDP upstreams are refused if both UDPPortMin and UDPPortMax are 0
+	UDPPortMin int `yaml:"udp_port_min,omitempty" json:"udp_port_min,omitempty"`
+
+	// UDPPortMax the last public port allocated to the UDP upstreams
+	UDPPortMax int `yaml:"udp_port_max,omitempty" json:"udp_port_max,omitempty"`
+
+	// UDPSessionTimeout the tunnel of a source address of a UDP upstream is
+	// closed when no datagram was exchanged for this long
+	UDPSessionTimeout types.Duration `yaml:"udp_session_timeout,omitempty" json:"udp_session_timeout,omitempty"`
 }
 
 // SetDefaults sets the default values.
 func (o *FrontendOptions) SetDefaults() {
 	o.HttpBindAddr = ":8080"
 	o.HttpsBindAddr = ":8443"
+	o.TCPPortMin = 20000
+	o.TCPPortMax = 29999
+	o.UDPPortMin = 30000
+	o.UDPPortMax = 39999
+	o.UDPSessionTimeout = types.Duration(time.Minute)
 	o.MaxHeaderBytes = http.DefaultMaxHeaderBytes
+	o.HTTP2MaxConcurrentStreams = 250
+	// the read and write timeouts would cut the long-lived streams proxied
+	// to the upstreams, the slow clients are bounded by the header and idle
+	// timeouts instead
 	o.IdleTimeout = types.Duration(time.Minute * 5)
-	o.ReadTimeout = types.Duration(time.Minute * 5)
 	o.ReadHeaderTimeout = types.Duration(time.Minute * 5)
 }
 
@@ -249,6 +320,46 @@ func (o *FrontendOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&o.MaxHeaderBytes, "frontend.max-header-bytes", o.MaxHeaderBytes, "MaxHeaderBytes controls the "+
 		"maximum number of bytes the frontend.will read parsing the request header's keys and  values, including the"+
 		" request line. It does not limit the size of the request body. If zero, DefaultMaxHeaderBytes is used.")
+
+	fs.Uint32Var(&o.HTTP2MaxConcurrentStreams, "frontend.http2-max-concurrent-streams", o.HTTP2MaxConcurrentStreams,
+		"The maximum number of concurrent streams of an HTTP/2 connection. The read-timeout and write-timeout "+
+			"bound every HTTP/2 stream too, they should be 0 for long-lived gRPC streams")
+
+	fs.StringVar(&o.DefaultCertificateFile, "frontend.default-certificate-file", o.DefaultCertificateFile,
+		"The certificate served over https when no certificate matches the requested hostname")
+
+	fs.StringVar(&o.DefaultCertificateKeyFile, "frontend.default-certificate-key-file", o.DefaultCertificateKeyFile,
+		"The private key of the default certificate")
+
+	fs.StringVar(&o.CertificateDir, "frontend.certificate-dir", o.CertificateDir, "The directory storing the "+
+		"certificates of the hostnames, uploaded certificates are kept in memory only if empty")
+
+	fs.StringVar(&o.BaseDomain, "frontend.base-domain", o.BaseDomain, "The domain a random subdomain of "+
+		"is allocated to the clients watching no hostname, they are refused if empty")
+
+	fs.StringSliceVar(&o.TrustedProxies, "frontend.trusted-proxies", o.TrustedProxies, "The CIDR ranges, or "+
+		"single addresses, of the proxies in front of the frontend whose X-Forwarded-For header is trusted")
+
+	fs.StringVar(&o.TCPBindHost, "frontend.tcp-bind-host", o.TCPBindHost, "The host the public ports "+
+		"of the TCP upstreams are bound on, all interfaces if empty")
+
+	fs.IntVar(&o.TCPPortMin, "frontend.tcp-port-min", o.TCPPortMin, "The first public port allocated to "+
+		"the TCP upstreams, TCP upstreams are refused if both tcp-port-min and tcp-port-max are 0")
+
+	fs.IntVar(&o.TCPPortMax, "frontend.tcp-port-max", o.TCPPortMax, "The last public port allocated to "+
+		"the TCP upstreams")
+
+	fs.StringVar(&o.UDPBindHost, "frontend.udp-bind-host", o.UDPBindHost, "The host the public ports "+
+		"of the UDP upstreams are bound on, all interfaces if empty")
+
+	fs.IntVar(&o.UDPPortMin, "frontend.udp-port-min", o.UDPPortMin, "The first public port allocated to "+
+		"the UDP upstreams, UDP upstreams are refused if both udp-port-min and udp-port-max are 0")
+
+	fs.IntVar(&o.UDPPortMax, "frontend.udp-port-max", o.UDPPortMax, "The last public port allocated to "+
+		"the UDP upstreams")
+
+	fs.Var(&o.UDPSessionTimeout, "frontend.udp-session-timeout", "The tunnel of a source address of a UDP "+
+		"upstream is closed when no datagram was exchanged for this long")
 }
 
 // Validate verify the configuration and return an error if correct
@@ -290,6 +401,42 @@ func (o *FrontendOptions) Validate() error {
 			return fmt.Errorf("default_certificate_key_file '%s' is not regular file", o.DefaultCertificateKeyFile)
 		}
 	}
+
+	if o.CertificateDir != "" {
+		stat, err := os.Stat(o.CertificateDir)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil && !stat.IsDir() {
+			return fmt.Errorf("certificate_dir '%s' is not directory", o.CertificateDir)
+		}
+	}
+
+	if o.BaseDomain != "" && !hostnamePattern.MatchString(o.BaseDomain) {
+		return fmt.Errorf("base_domain '%s' is not a hostname", o.BaseDomain)
+	}
+
+	for i, proxy := range o.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return fmt.Errorf("trusted_proxies[%d] '%s' is no CIDR or address", i, proxy)
+		}
+	}
+
+	if o.TCPPortMin != 0 || o.TCPPortMax != 0 {
+		if o.TCPPortMin <= 0 || o.TCPPortMax > 65535 || o.TCPPortMin > o.TCPPortMax {
+			return fmt.Errorf("tcp_port_min/tcp_port_max must be a range of ports")
+		}
+	}
+
+	if o.UDPPortMin != 0 || o.UDPPortMax != 0 {
+		if o.UDPPortMin <= 0 || o.UDPPortMax > 65535 || o.UDPPortMin > o.UDPPortMax {
+			return fmt.Errorf("udp_port_min/udp_port_max must be a range of ports")
+		}
+
+		if o.UDPSessionTimeout <= 0 {
+			return fmt.Errorf("udp_session_timeout must be greater than 0")
+		}
+	}
 	return nil
 }
 
@@ -300,7 +447,8 @@ type BackendOptions struct {
 
 	// ReadTimeout is the maximum duration for reading the entire
 	// request, including the body. A zero or negative value means
-	// there will be no timeout.
+	// there will be no timeout. The grpc streams, which live as
+	// long as their tunnels, are exempt.
 	ReadTimeout types.Duration `yaml:"read_timeout,omitempty" json:"read_timeout,omitempty"`
 
 	// ReadHeaderTimeout is the amount of time allowed to read
@@ -316,6 +464,7 @@ type BackendOptions struct {
 	// request's header is read. Like ReadTimeout, it does not
 	// let Handlers make decisions on a per-request basis.
 	// A zero or negative value means there will be no timeout.
+	// The grpc streams are exempt.
 	WriteTimeout types.Duration `yaml:"write_timeout,omitempty" json:"write_timeout,omitempty"`
 
 	// IdleTimeout is the maximum amount of time to wait for the
@@ -347,11 +496,17 @@ type BackendOptions struct {
 
 	// private key of the web service
 	CertificateKeyFile string `yaml:"certificate_key_file,omitempty" json:"certificate_key_file,omitempty"`
+
+	// ResumeTimeout the upstream of a client whose WatchTunnels stream dropped
+	// is kept this long, so that the client resumes it once reconnected. It
+	// is removed at once if 0.
+	ResumeTimeout types.Duration `yaml:"resume_timeout,omitempty" json:"resume_timeout,omitempty"`
 }
 
 // SetDefaults sets the default values.
 func (o *BackendOptions) SetDefaults() {
 	o.BindAddr = ":8090"
+	o.ResumeTimeout = types.Duration(time.Minute)
 	o.ClientAuthMode = "request"
 	o.MaxHeaderBytes = http.DefaultMaxHeaderBytes
 	o.IdleTimeout = types.Duration(time.Minute * 5)
@@ -391,6 +546,9 @@ func (o *BackendOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&o.MaxHeaderBytes, "server.max-header-bytes", o.MaxHeaderBytes, "MaxHeaderBytes controls the "+
 		"maximum number of bytes the server will read parsing the request header's keys and  values, including the"+
 		" request line. It does not limit the size of the request body. If zero, DefaultMaxHeaderBytes is used.")
+
+	fs.Var(&o.ResumeTimeout, "server.resume-timeout", "The upstream of a client whose WatchTunnels stream "+
+		"dropped is kept this long, so that the client resumes it once reconnected. It is removed at once if 0")
 }
 
 // Validate verify the configuration and return an error if correct
@@ -399,6 +557,10 @@ func (o *BackendOptions) Validate() error {
 		return fmt.Errorf("bind_addr is required field")
 	}
 
+	if o.ResumeTimeout < 0 {
+		return fmt.Errorf("resume_timeout must not be negative")
+	}
+
 	for _, caFile := range o.RootCAPool {
 		stat, err := os.Stat(caFile)
 		if err != nil {
@@ -445,10 +607,357 @@ func (o *BackendOptions) Validate() error {
 	return nil
 }
 
+// TokenOptions json web token related configuration
+type TokenOptions struct {
+	// Issuer the issuer claim of the tokens signed by the server
+	Issuer string `yaml:"issuer,omitempty" json:"issuer,omitempty"`
+
+	// SigningKeys list of HMAC keys used to verify tokens, the first key is
+	// also used to sign new tokens. Keep the retired keys at the end of the
+	// list until every token signed by them has expired to rotate keys
+	// without logging out clients.
+	SigningKeys []string `yaml:"signing_keys,omitempty" json:"signing_keys,omitempty"`
+
+	// ExpiresIn is the lifetime of the session token returned by login
+	ExpiresIn types.Duration `yaml:"expires_in,omitempty" json:"expires_in,omitempty"`
+
+	// TunnelExpiresIn is the lifetime of the one-time tunnel tokens sent to
+	// the clients watching tunnels, a client must connect the tunnel before
+	// its token expires
+	TunnelExpiresIn types.Duration `yaml:"tunnel_expires_in,omitempty" json:"tunnel_expires_in,omitempty"`
+}
+
+// SetDefaults sets the default values.
+func (o *TokenOptions) SetDefaults() {
+	o.Issuer = "kun"
+	o.ExpiresIn = types.Duration(time.Hour * 24)
+	o.TunnelExpiresIn = types.Duration(time.Second * 30)
+}
+
+// AddFlags add token related command line parameters
+func (o *TokenOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Issuer, "token.issuer", o.Issuer, "The issuer claim of the tokens signed by the server")
+
+	fs.StringArrayVar(&o.SigningKeys, "token.signing-keys", o.SigningKeys, "List of HMAC keys used to "+
+		"verify tokens, the first key is also used to sign new tokens.")
+
+	fs.Var(&o.ExpiresIn, "token.expires-in", "The lifetime of the session token returned by login")
+
+	fs.Var(&o.TunnelExpiresIn, "token.tunnel-expires-in", "The lifetime of the one-time tunnel tokens "+
+		"sent to the clients watching tunnels")
+}
+
+// Validate verify the configuration and return an error if correct
+func (o *TokenOptions) Validate() error {
+	if len(o.SigningKeys) == 0 {
+		return fmt.Errorf("signing_keys is required field")
+	}
+
+	for i, key := range o.SigningKeys {
+		if len(key) < minSigningKeyLength {
+			return fmt.Errorf("signing_keys[%d] must be at least %d bytes", i, minSigningKeyLength)
+		}
+	}
+
+	if o.ExpiresIn <= 0 {
+		return fmt.Errorf("expires_in must be greater than 0")
+	}
+
+	if o.TunnelExpiresIn <= 0 {
+		return fmt.Errorf("tunnel_expires_in must be greater than 0")
+	}
+	return nil
+}
+
+// ACMEOptions automatic certificate management related configuration
+type ACMEOptions struct {
+	// Enabled obtain and renew the certificates of the https upstreams over
+	// ACME, enabling it accepts the terms of service of the CA
+	Enabled bool `yaml:"enabled,omitempty" json:"enabled,omitempty"`
+
+	// DirectoryURL the directory endpoint of the ACME server
+	DirectoryURL string `yaml:"directory_url,omitempty" json:"directory_url,omitempty"`
+
+	// Email the contact address of the ACME account, optional
+	Email string `yaml:"email,omitempty" json:"email,omitempty"`
+
+	// CacheDir the directory persisting the account key and the certificates
+	CacheDir string `yaml:"cache_dir,omitempty" json:"cache_dir,omitempty"`
+
+	// RenewBefore renew a certificate this long before it expires
+	RenewBefore types.Duration `yaml:"renew_before,omitempty" json:"renew_before,omitempty"`
+
+	// TrustedCAFile the certificate authorities trusted to serve the ACME
+	// directory in addition to the system ones, e.g. the root of Pebble
+	TrustedCAFile string `yaml:"trusted_ca_file,omitempty" json:"trusted_ca_file,omitempty"`
+}
+
+// SetDefaults sets the default values.
+func (o *ACMEOptions) SetDefaults() {
+	o.DirectoryURL = "https://acme-v02.api.letsencrypt.org/directory"
+	o.CacheDir = "./acme"
+	o.RenewBefore = types.Duration(time.Hour * 24 * 30)
+}
+
+// AddFlags add acme related command line parameters
+func (o *ACMEOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enabled, "acme.enabled", o.Enabled, "Obtain and renew the certificates of the https "+
+		"upstreams over ACME, enabling it accepts the terms of service of the CA")
+
+	fs.StringVar(&o.DirectoryURL, "acme.directory-url", o.DirectoryURL, "The directory endpoint of the ACME server")
+
+	fs.StringVar(&o.Email, "acme.email", o.Email, "The contact address of the ACME account")
+
+	fs.StringVar(&o.CacheDir, "acme.cache-dir", o.CacheDir, "The directory persisting the account key "+
+		"and the certificates")
+
+	fs.Var(&o.RenewBefore, "acme.renew-before", "Renew a certificate this long before it expires")
+
+	fs.StringVar(&o.TrustedCAFile, "acme.trusted-ca-file", o.TrustedCAFile, "The certificate authorities "+
+		"trusted to serve the ACME directory in addition to the system ones")
+}
+
+// Validate verify the configuration and return an error if correct
+func (o *ACMEOptions) Validate() error {
+	if !o.Enabled {
+		return nil
+	}
+
+	if o.DirectoryURL == "" {
+		return fmt.Errorf("directory_url is required field")
+	}
+
+	if o.CacheDir == "" {
+		return fmt.Errorf("cache_dir is required field")
+	}
+
+	if o.RenewBefore <= 0 {
+		return fmt.Errorf("renew_before must be greater than 0")
+	}
+
+	if o.TrustedCAFile != "" {
+		stat, err := os.Stat(o.TrustedCAFile)
+		if err != nil {
+			return err
+		}
+		if !stat.Mode().IsRegular() {
+			return fmt.Errorf("trusted_ca_file '%s' is not regular file", o.TrustedCAFile)
+		}
+	}
+	return nil
+}
+
+// DomainOptions custom domain ownership verification related configuration
+type DomainOptions struct {
+	// Enabled let the access keys claim custom domains, outside the base
+	// domain of the frontend, and watch them once their ownership is proven
+	// over DNS only, whatever the auth plugin allows. The hostnames of the
+	// base domain are allowed by the auth plugin.
+	Enabled bool `yaml:"enabled,omitempty" json:"enabled,omitempty"`
+
+	// ChallengePrefix the label prepended to a claimed domain to name the TXT
+	// record proving its ownership, e.g. _kun-challenge.app.customer.com
+	ChallengePrefix string `yaml:"challenge_prefix,omitempty" json:"challenge_prefix,omitempty"`
+
+	// CNAMETarget the hostname of the edge, a claimed domain may instead be
+	// proven by a CNAME record pointing at <token>.<cname_target>. Only TXT
+	// records are accepted if empty
+	CNAMETarget string `yaml:"cname_target,omitempty" json:"cname_target,omitempty"`
+
+	// Nameserver the host:port of the DNS server queried for the records, the
+	// system resolver if empty
+	Nameserver string `yaml:"nameserver,omitempty" json:"nameserver,omitempty"`
+
+	// ClaimTimeout a claim is forgotten unless it is verified in this long
+	ClaimTimeout types.Duration `yaml:"claim_timeout,omitempty" json:"claim_timeout,omitempty"`
+}
+
+// SetDefaults sets the default values.
+func (o *DomainOptions) SetDefaults() {
+	o.ChallengePrefix = "_kun-challenge"
+	o.ClaimTimeout = types.Duration(time.Hour * 72)
+}
+
+// AddFlags add custom domain related command line parameters
+func (o *DomainOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enabled, "domain.enabled", o.Enabled, "Let the access keys claim custom domains "+
+		"outside frontend.base-domain and watch them only once their ownership is proven over DNS")
+
+	fs.StringVar(&o.ChallengePrefix, "domain.challenge-prefix", o.ChallengePrefix, "The label prepended "+
+		"to a claimed domain to name the TXT record proving its ownership")
+
+	fs.StringVar(&o.CNAMETarget, "domain.cname-target", o.CNAMETarget, "The hostname of the edge, a "+
+		"claimed domain may be proven by a CNAME record pointing at <token>.<cname-target>")
+
+	fs.StringVar(&o.Nameserver, "domain.nameserver", o.Nameserver, "The host:port of the DNS server "+
+		"queried for the records, the system resolver if empty")
+
+	fs.Var(&o.ClaimTimeout, "domain.claim-timeout", "A claim is forgotten unless it is verified in this long")
+}
+
+// Validate verify the configuration and return an error if correct
+func (o *DomainOptions) Validate() error {
+	if !o.Enabled {
+		return nil
+	}
+
+	if !challengePrefixPattern.MatchString(o.ChallengePrefix) {
+		return fmt.Errorf("challenge_prefix '%s' is not valid label", o.ChallengePrefix)
+	}
+
+	if o.CNAMETarget != "" && !hostnamePattern.MatchString(o.CNAMETarget) {
+		return fmt.Errorf("cname_target '%s' is not valid hostname", o.CNAMETarget)
+	}
+
+	if o.Nameserver != "" {
+		if _, _, err := net.SplitHostPort(o.Nameserver); err != nil {
+			return fmt.Errorf("nameserver '%s' is not valid host:port, got: %w", o.Nameserver, err)
+		}
+	}
+
+	if o.ClaimTimeout <= 0 {
+		return fmt.Errorf("claim_timeout must be greater than 0")
+	}
+	return nil
+}
+
+// LimitOptions rate limiting related configuration, the token buckets of a
+// hostname are shared by all of its upstreams and those of an access key by
+// all of its hostnames. A limit of 0 is unlimited.
+type LimitOptions struct {
+	// HostnameRequestsPerSecond the requests per second allowed to the frontend for a hostname
+	HostnameRequestsPerSecond float64 `yaml:"hostname_requests_per_second,omitempty" json:"hostname_requests_per_second,omitempty"`
+
+	// HostnameRequestBurst the requests of a hostname allowed at once, the
+	// requests per second rounded up if 0
+	HostnameRequestBurst int `yaml:"hostname_request_burst,omitempty" json:"hostname_request_burst,omitempty"`
+
+	// AccessKeyRequestsPerSecond the requests per second allowed to the frontend for the hostnames of an access key
+	AccessKeyRequestsPerSecond float64 `yaml:"access_key_requests_per_second,omitempty" json:"access_key_requests_per_second,omitempty"`
+
+	// AccessKeyRequestBurst the requests of an access key allowed at once,
+	// the requests per second rounded up if 0
+	AccessKeyRequestBurst int `yaml:"access_key_request_burst,omitempty" json:"access_key_request_burst,omitempty"`
+
+	// HostnameBytesPerSecond the bytes per second moved through the tunnels of a
+	// hostname in either direction, one second worth of bytes may be moved at once
+	HostnameBytesPerSecond int `yaml:"hostname_bytes_per_second,omitempty" json:"hostname_bytes_per_second,omitempty"`
+
+	// AccessKeyBytesPerSecond the bytes per second moved through the tunnels of
+	// an access key in either direction
+	AccessKeyBytesPerSecond int `yaml:"access_key_bytes_per_second,omitempty" json:"access_key_bytes_per_second,omitempty"`
+}
+
+// SetDefaults sets the default values, nothing is limited by default.
+func (o *LimitOptions) SetDefaults() {
+	*o = LimitOptions{}
+}
+
+// AddFlags add rate limiting related command line parameters
+func (o *LimitOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.Float64Var(&o.HostnameRequestsPerSecond, "limit.hostname-requests-per-second", o.HostnameRequestsPerSecond,
+		"The requests per second allowed to the frontend for a hostname, unlimited if 0")
+
+	fs.IntVar(&o.HostnameRequestBurst, "limit.hostname-request-burst", o.HostnameRequestBurst, "The requests "+
+		"of a hostname allowed at once, the requests per second rounded up if 0")
+
+	fs.Float64Var(&o.AccessKeyRequestsPerSecond, "limit.access-key-requests-per-second", o.AccessKeyRequestsPerSecond,
+		"The requests per second allowed to the frontend for the hostnames of an access key, unlimited if 0")
+
+	fs.IntVar(&o.AccessKeyRequestBurst, "limit.access-key-request-burst", o.AccessKeyRequestBurst, "The "+
+		"requests of an access key allowed at once, the requests per second rounded up if 0")
+
+	fs.IntVar(&o.HostnameBytesPerSecond, "limit.hostname-bytes-per-second", o.HostnameBytesPerSecond, "The "+
+		"bytes per second moved through the tunnels of a hostname in either direction, unlimited if 0")
+
+	fs.IntVar(&o.AccessKeyBytesPerSecond, "limit.access-key-bytes-per-second", o.AccessKeyBytesPerSecond, "The "+
+		"bytes per second moved through the tunnels of an access key in either direction, unlimited if 0")
+}
+
+// Validate verify the configuration and return an error if correct
+func (o *LimitOptions) Validate() error {
+	if o.HostnameRequestsPerSecond < 0 || o.AccessKeyRequestsPerSecond < 0 {
+		return fmt.Errorf("hostname_requests_per_second and access_key_requests_per_second must not be negative")
+	}
+
+	if o.HostnameRequestBurst < 0 || o.AccessKeyRequestBurst < 0 {
+		return fmt.Errorf("hostname_request_burst and access_key_request_burst must not be negative")
+	}
+
+	if o.HostnameBytesPerSecond < 0 || o.AccessKeyBytesPerSecond < 0 {
+		return fmt.Errorf("hostname_bytes_per_second and access_key_bytes_per_second must not be negative")
+	}
+	return nil
+}
+
+// HostnameCredentials the credentials the frontend requires from the clients of a hostname
+type HostnameCredentials struct {
+	// Hostname the hostname, or the wildcard hostname, the credentials are required for
+	Hostname string `yaml:"hostname,omitempty" json:"hostname,omitempty"`
+
+	// BasicAuth the username:password pairs of the Basic credentials
+	BasicAuth []string `yaml:"basic_auth,omitempty" json:"basic_auth,omitempty"`
+
+	// BearerTokens the static tokens accepted as Bearer credentials
+	BearerTokens []string `yaml:"bearer_tokens,omitempty" json:"bearer_tokens,omitempty"`
+}
+
+// GateOptions the credentials required by the frontend for the hostnames,
+// in place of those the clients registered the hostnames with
+type GateOptions struct {
+	// Hostnames the credentials of the hostnames
+	Hostnames []HostnameCredentials `yaml:"hostnames,omitempty" json:"hostnames,omitempty"`
+}
+
+// SetDefaults sets the default values, no hostname is gated by default.
+func (o *GateOptions) SetDefaults() {
+	*o = GateOptions{}
+}
+
+// AddFlags add gate related command line parameters, the credentials are
+// read from the configuration file only
+func (o *GateOptions) AddFlags(_ *pflag.FlagSet) {}
+
+// Validate verify the configuration and return an error if correct
+func (o *GateOptions) Validate() error {
+	seen := make(map[string]bool, len(o.Hostnames))
+
+	for i, h := range o.Hostnames {
+		hostname := strings.ToLower(h.Hostname)
+		if hostname == "" || seen[hostname] {
+			return fmt.Errorf("hostnames[%d] must have a unique hostname", i)
+		}
+		seen[hostname] = true
+
+		if len(h.BasicAuth) == 0 && len(h.BearerTokens) == 0 {
+			return fmt.Errorf("hostnames[%d] must have basic_auth or bearer_tokens", i)
+		}
+
+		for j, pair := range h.BasicAuth {
+			if username, password, _ := strings.Cut(pair, ":"); username == "" || password == "" {
+				return fmt.Errorf("hostnames[%d].basic_auth[%d] must be username:password", i, j)
+			}
+		}
+
+		for j, token := range h.BearerTokens {
+			if !bearerTokenPattern.MatchString(token) {
+				return fmt.Errorf("hostnames[%d].bearer_tokens[%d] is no bearer token", i, j)
+			}
+		}
+	}
+	return nil
+}
+
 // Configuration Profile contents
 type Configuration struct {
 	Log      *log.Options     `yaml:"log,omitempty" json:"log,omitempty"`
 	Auth     *auth.Options    `yaml:"auth,omitempty" json:"auth,omitempty"`
+	Token    *TokenOptions    `yaml:"token,omitempty" json:"token,omitempty"`
+	Tunnel   *tunnel.Options  `yaml:"tunnel,omitempty" json:"tunnel,omitempty"`
+	ACME     *ACMEOptions     `yaml:"acme,omitempty" json:"acme,omitempty"`
+	Domain   *DomainOptions   `yaml:"domain,omitempty" json:"domain,omitempty"`
+	Limit    *LimitOptions    `yaml:"limit,omitempty" json:"limit,omitempty"`
+	Gate     *GateOptions     `yaml:"gate,omitempty" json:"gate,omitempty"`
 	Peer     *PeerOptions     `yaml:"peer,omitempty" json:"peer,omitempty"`
 	Frontend *FrontendOptions `yaml:"frontend,omitempty" json:"frontend,omitempty"`
 	Backend  *BackendOptions  `yaml:"backend,omitempty" json:"backend,omitempty"`
@@ -457,6 +966,13 @@ type Configuration struct {
 // AddFlags   added the configuration  flag to the  specified pflag.FlagSet
 func (c *Configuration) AddFlags(fs *pflag.FlagSet) {
 	c.Log.AddFlags(fs)
+	c.Auth.AddFlags(fs)
+	c.Token.AddFlags(fs)
+	c.Tunnel.AddFlags(fs)
+	c.ACME.AddFlags(fs)
+	c.Domain.AddFlags(fs)
+	c.Limit.AddFlags(fs)
+	c.Gate.AddFlags(fs)
 	c.Peer.AddFlags(fs)
 	c.Frontend.AddFlags(fs)
 	c.Backend.AddFlags(fs)
@@ -465,6 +981,13 @@ func (c *Configuration) AddFlags(fs *pflag.FlagSet) {
 // SetDefaults sets the default values.
 func (c *Configuration) SetDefaults() {
 	c.Log.SetDefaults()
+	c.Auth.SetDefaults()
+	c.Token.SetDefaults()
+	c.Tunnel.SetDefaults()
+	c.ACME.SetDefaults()
+	c.Domain.SetDefaults()
+	c.Limit.SetDefaults()
+	c.Gate.SetDefaults()
 	c.Peer.SetDefaults()
 	c.Frontend.SetDefaults()
 	c.Backend.SetDefaults()
@@ -478,6 +1001,38 @@ func (c *Configuration) Validate() error {
 		errs = append(errs, fmt.Errorf("log: %w", err))
 	}
 
+	if err := c.Auth.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("auth: %w", err))
+	}
+
+	if err := c.Token.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("token: %w", err))
+	}
+
+	if err := c.Tunnel.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("tunnel: %w", err))
+	}
+
+	if err := c.ACME.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("acme: %w", err))
+	}
+
+	if err := c.Domain.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("domain: %w", err))
+	}
+
+	if c.Domain.Enabled && c.Frontend.BaseDomain == "" {
+		errs = append(errs, fmt.Errorf("domain: enabled requires frontend.base_domain, which tells the custom domains apart"))
+	}
+
+	if err := c.Limit.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("limit: %w", err))
+	}
+
+	if err := c.Gate.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("gate: %w", err))
+	}
+
 	if err := c.Peer.Validate(); err != nil {
 		errs = append(errs, fmt.Errorf("peer: %w", err))
 	}
@@ -498,6 +1053,12 @@ func NewConfiguration() *Configuration {
 	return &Configuration{
 		Log:      log.NewOptions(),
 		Auth:     auth.NewOptions(),
+		Token:    new(TokenOptions),
+		Tunnel:   tunnel.NewOptions(),
+		ACME:     new(ACMEOptions),
+		Domain:   new(DomainOptions),
+		Limit:    new(LimitOptions),
+		Gate:     new(GateOptions),
 		Peer:     new(PeerOptions),
 		Backend:  new(BackendOptions),
 		Frontend: new(FrontendOptions),
@@ -37,3 +37,17 @@ func TestConfiguration_Flags(t *testing.T) {
 
 	fmt.Println(cfg.Log.Level)
 }
+
+func TestFrontendOptions_SetDefaults(t *testing.T) {
+	cfg := config.NewConfiguration()
+	cfg.SetDefaults()
+
+	// the proxied streams are long-lived, e.g. websockets and gRPC streams
+	if cfg.Frontend.ReadTimeout != 0 || cfg.Frontend.WriteTimeout != 0 {
+		t.Fatalf("expected no read or write timeout, got %d %d", cfg.Frontend.ReadTimeout, cfg.Frontend.WriteTimeout)
+	}
+
+	if cfg.Frontend.ReadHeaderTimeout <= 0 || cfg.Frontend.IdleTimeout <= 0 {
+		t.Fatalf("expected the slow clients bounded, got %d %d", cfg.Frontend.ReadHeaderTimeout, cfg.Frontend.IdleTimeout)
+	}
+}
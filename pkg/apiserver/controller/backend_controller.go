@@ -15,26 +15,446 @@ package controller
 
 import (
 	"context"
+	"errors"
 	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/middleware"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/auth"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
 )
 
 var _ v1.BackendControllerServer = (*BackendController)(nil)
 
+// BackendController implements the api used by the clients behind NAT
 type BackendController struct {
 	v1.BackendControllerServer
+
+	plugin       auth.PluginInterface
+	tokens       *service.TokenService
+	upstreams    *service.UpstreamService
+	certificates *service.CertificateService
+	domains      *service.DomainService
+	limits       *service.LimitService
+	opts         *tunnel.Options
 }
 
+// WatchTunnels registers the hostname of the caller and streams a tunnel token
+// whenever the server needs one more tunnel connected to the hostname. The
+// caller watching no hostname is allocated a subdomain of the base domain
+// without permission check.
 func (b *BackendController) WatchTunnels(request *v1.WatchTunnelsRequest, server v1.BackendController_WatchTunnelsServer) error {
-	//TODO implement me
-	panic("implement me")
+	ctx := server.Context()
+	l := log.FromContext(ctx).Sugar()
+
+	if err := request.ValidateAll(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims, ok := middleware.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	// the permission is checked on the hostname the upstream is registered with
+	request.Hostname = service.NormalizeHostname(request.Hostname)
+
+	if request.Hostname != "" {
+		ok, err := b.hasPermission(ctx, claims.Subject, request.Hostname)
+		if err != nil {
+			l.Errorf("unable check permission of %s on %s, got: %v", claims.Subject, request.Hostname, err)
+			return status.Error(codes.Internal, "unable check permission")
+		}
+
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "access key %s is not allowed to use %s",
+				claims.Subject, request.Hostname)
+		}
+	}
+
+	// the window is bounded by the server, the tunnels of the upstream are
+	// built with the one negotiated
+	request.WindowSize = int32(b.opts.NegotiateWindowSize(int(request.WindowSize)))
+
+	err := b.upstreams.Watch(ctx, claims.Subject, request, server.Send)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, service.ErrUpstreamExists), errors.Is(err, service.ErrPortInUse):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrPortOutOfRange), errors.Is(err, service.ErrInvalidPoolSize),
+		errors.Is(err, service.ErrInvalidRoute), errors.Is(err, service.ErrHostnameRequired),
+		errors.Is(err, service.ErrInvalidHeaderRule), errors.Is(err, service.ErrInvalidAccessPolicy),
+		errors.Is(err, service.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrPortExhausted):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrProtocolUnsupported):
+		return status.Error(codes.Unimplemented, err.Error())
+	default:
+		l.Errorf("watch tunnels of %s failed, got: %v", request.Hostname, err)
+		return status.Error(codes.Unavailable, err.Error())
+	}
 }
 
+// ConnectTunnel binds the stream to the pool of the hostname in the one-time
+// tunnel token, the stream then carries the bytes of one proxied connection
+// within the bandwidth of the hostname and its access key
 func (b *BackendController) ConnectTunnel(server v1.BackendController_ConnectTunnelServer) error {
-	//TODO implement me
-	panic("implement me")
+	ctx := server.Context()
+	l := log.FromContext(ctx).Sugar()
+
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(tunnel.TokenKey); len(values) > 0 {
+			token = values[0]
+		}
+	}
+
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "tunnel token is required")
+	}
+
+	claims, err := b.tokens.ParseTunnelToken(token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	u, err := b.upstreams.Lookup(claims.Hostname, claims.Upstream)
+	if err != nil {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	if u.AccessKeyId != claims.Subject {
+		return status.Errorf(codes.PermissionDenied, "access key %s does not watch %s",
+			claims.Subject, claims.Hostname)
+	}
+
+	addr := claims.Hostname + "/" + claims.ID
+
+	// the codec negotiated by WatchTunnels, nil if none
+	codec, _ := tunnel.GetCodec(u.Compression)
+
+	// the window negotiated by WatchTunnels
+	opts := b.opts.WithWindowSize(int(u.WindowSize))
+
+	var limiter tunnel.Limiter
+	if b.limits != nil {
+		var release func()
+		limiter, release = b.limits.Bandwidth(u.DomainName, u.AccessKeyId)
+		defer release()
+	}
+
+	if u.Multiplex {
+		session := tunnel.NewServerSession(server, opts, addr)
+		session.SetCodec(codec)
+		session.SetLimiter(limiter)
+
+		if err := u.Pool().PutSession(claims.ID, session); err != nil {
+			_ = session.Close()
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		<-session.Done()
+		u.Pool().RemoveSession(session)
+
+		if err := session.Err(); err != nil {
+			l.Debugf("tunnel session %s of %s terminated, got: %v", claims.ID, claims.Hostname, err)
+		}
+		return nil
+	}
+
+	conn := tunnel.NewConn(server, opts, addr)
+	conn.SetCodec(codec)
+	conn.SetLimiter(limiter)
+
+	if err := u.Pool().Put(claims.ID, conn); err != nil {
+		_ = conn.Close()
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	<-conn.Done()
+	u.Pool().Remove(conn)
+
+	if err := conn.Err(); err != nil {
+		l.Debugf("tunnel %s of %s terminated, got: %v", claims.ID, claims.Hostname, err)
+	}
+	return nil
 }
 
+// GetUpstreamStats returns the usage of the tunnel pool of an upstream of a
+// hostname watched by the caller, the hostnames of the others are not found
+func (b *BackendController) GetUpstreamStats(ctx context.Context, request *v1.GetUpstreamStatsRequest) (*v1.GetUpstreamStatsResponse, error) {
+	if err := request.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	hostname := service.NormalizeHostname(request.Hostname)
+
+	u, err := b.upstreams.Get(hostname)
+	if err == nil && request.UpstreamId != "" {
+		u, err = b.upstreams.Lookup(hostname, request.UpstreamId)
+	}
+
+	if err != nil || u.AccessKeyId != claims.Subject {
+		return nil, status.Errorf(codes.NotFound, "%s is not watched by access key %s", hostname, claims.Subject)
+	}
+
+	upstreamIds := make([]string, 0)
+	for _, m := range b.upstreams.List(hostname) {
+		upstreamIds = append(upstreamIds, m.ID)
+	}
+
+	stats := u.Pool().Stats()
+
+	return &v1.GetUpstreamStatsResponse{
+		UpstreamId:     u.ID,
+		Hostname:       u.DomainName,
+		PoolSize:       int32(stats.Size),
+		MinPoolSize:    int32(stats.MinSize),
+		MaxPoolSize:    int32(stats.MaxSize),
+		Idle:           int32(stats.Idle),
+		Active:         int32(stats.Active),
+		Pending:        int32(stats.Pending),
+		Waiting:        int32(stats.Waiting),
+		Sessions:       int32(stats.Sessions),
+		PeakActive:     int32(stats.PeakActive),
+		Acquired:       stats.Acquired,
+		ScaleUps:       stats.ScaleUps,
+		ScaleDowns:     stats.ScaleDowns,
+		AcquireWaitAvg: durationpb.New(stats.AcquireWaitAvg),
+		AcquireWaitMax: durationpb.New(stats.AcquireWaitMax),
+		LoadBalancing:  u.LoadBalancing,
+		Weight:         u.Weight,
+		UpstreamIds:    upstreamIds,
+		PathPrefix:     u.PathPrefix,
+	}, nil
+}
+
+// ReportHealth marks an upstream watched by the caller healthy or unhealthy,
+// the frontend routes no request to an unhealthy upstream
+func (b *BackendController) ReportHealth(ctx context.Context, request *v1.ReportHealthRequest) (*v1.ReportHealthResponse, error) {
+	l := log.FromContext(ctx).Sugar()
+
+	if err := request.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	hostname := service.NormalizeHostname(request.Hostname)
+
+	upstreamStatus, changed, err := b.upstreams.SetHealthy(claims.Subject, hostname, request.UpstreamId, request.Healthy)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "upstream %s of %s is not watched by access key %s",
+			request.UpstreamId, hostname, claims.Subject)
+	}
+
+	switch {
+	case changed && request.Healthy:
+		l.Infof("upstream %s of %s is healthy again", request.UpstreamId, hostname)
+	case changed:
+		l.Warnf("upstream %s of %s is unhealthy: %s", request.UpstreamId, hostname, request.Reason)
+	}
+	return &v1.ReportHealthResponse{Status: upstreamStatus}, nil
+}
+
+// UploadCertificate stores the certificate served over https for a hostname
+// which the caller is allowed to use
+func (b *BackendController) UploadCertificate(ctx context.Context, request *v1.UploadCertificateRequest) (*v1.UploadCertificateResponse, error) {
+	l := log.FromContext(ctx).Sugar()
+
+	if err := request.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	hostname := service.NormalizeHostname(request.Hostname)
+
+	ok, err := b.hasPermission(ctx, claims.Subject, hostname)
+	if err != nil {
+		l.Errorf("unable check permission of %s on %s, got: %v", claims.Subject, hostname, err)
+		return nil, status.Error(codes.Internal, "unable check permission")
+	}
+
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "access key %s is not allowed to use %s",
+			claims.Subject, hostname)
+	}
+
+	leaf, err := b.certificates.Upload(ctx, hostname, []byte(request.Certificate), []byte(request.PrivateKey))
+	switch {
+	case errors.Is(err, service.ErrInvalidCertificate):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		l.Errorf("unable store certificate of %s, got: %v", hostname, err)
+		return nil, status.Error(codes.Internal, "unable store certificate")
+	}
+
+	l.Infof("certificate of %s uploaded by %s, expired at %s", hostname, claims.Subject, leaf.NotAfter)
+
+	return &v1.UploadCertificateResponse{
+		Hostname:  hostname,
+		DnsNames:  leaf.DNSNames,
+		ExpiredAt: leaf.NotAfter.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// ClaimDomain starts the ownership verification of a custom domain by the
+// caller and returns the DNS records proving it
+func (b *BackendController) ClaimDomain(ctx context.Context, request *v1.ClaimDomainRequest) (*v1.ClaimDomainResponse, error) {
+	l := log.FromContext(ctx).Sugar()
+
+	if err := request.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if b.domains == nil {
+		return nil, status.Error(codes.Unimplemented, "custom domains are disabled")
+	}
+
+	claims, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	domain, err := b.domains.Claim(claims.Subject, request.Hostname)
+	switch {
+	case errors.Is(err, service.ErrDomainClaimed):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case err != nil:
+		l.Errorf("unable claim %s for %s, got: %v", request.Hostname, claims.Subject, err)
+		return nil, status.Error(codes.Internal, "unable claim domain")
+	}
+
+	response := &v1.ClaimDomainResponse{
+		Hostname:    domain.Hostname,
+		Status:      domain.Status,
+		TxtName:     b.domains.ChallengeName(domain.Hostname),
+		TxtValue:    domain.Token,
+		CnameTarget: b.domains.CNAMETarget(domain.Token),
+	}
+
+	if !domain.ExpiredAt.IsZero() {
+		response.ExpiredAt = domain.ExpiredAt.UTC().Format(time.RFC3339)
+	}
+	return response, nil
+}
+
+// VerifyDomain checks the DNS records of a custom domain claimed by the
+// caller, the caller may watch the domain once its ownership is proven
+func (b *BackendController) VerifyDomain(ctx context.Context, request *v1.VerifyDomainRequest) (*v1.VerifyDomainResponse, error) {
+	l := log.FromContext(ctx).Sugar()
+
+	if err := request.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if b.domains == nil {
+		return nil, status.Error(codes.Unimplemented, "custom domains are disabled")
+	}
+
+	claims, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	domain, err := b.domains.Verify(ctx, claims.Subject, request.Hostname)
+	switch {
+	case errors.Is(err, service.ErrDomainNotFound):
+		return nil, status.Errorf(codes.NotFound, "%s is not claimed by access key %s",
+			request.Hostname, claims.Subject)
+	case errors.Is(err, service.ErrDomainClaimed):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrDomainUnverified):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		l.Errorf("unable verify %s for %s, got: %v", request.Hostname, claims.Subject, err)
+		return nil, status.Error(codes.Internal, "unable verify domain")
+	}
+
+	l.Infof("ownership of %s proven by %s over %s record", domain.Hostname, claims.Subject, domain.Method)
+
+	return &v1.VerifyDomainResponse{
+		Hostname:   domain.Hostname,
+		Status:     domain.Status,
+		Method:     domain.Method,
+		VerifiedAt: domain.VerifiedAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// hasPermission returns true if the auth plugin allows the hostname to the
+// access key. A custom domain, outside the base domain of the server, is
+// allowed only once the access key proved its ownership.
+func (b *BackendController) hasPermission(ctx context.Context, accessKeyId, hostname string) (bool, error) {
+	if b.domains != nil && b.domains.Custom(hostname) {
+		return b.domains.Verified(accessKeyId, hostname), nil
+	}
+	return b.plugin.HasPermission(ctx, accessKeyId, hostname)
+}
+
+// Login authenticate the access key of the client and issue a session token
 func (b *BackendController) Login(ctx context.Context, request *v1.LoginRequest) (*v1.LoginResponse, error) {
-	//TODO implement me
-	panic("implement me")
+	l := log.FromContext(ctx).Sugar()
+
+	if err := request.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ok, err := b.plugin.Authenticate(ctx, request.AccessKeyId, request.SecretAccessKey)
+	if err != nil {
+		l.Errorf("unable authenticate access key %s, got: %v", request.AccessKeyId, err)
+		return nil, status.Error(codes.Internal, "unable authenticate access key")
+	}
+
+	if !ok {
+		l.Warnf("login failed for access key %s from %s/%s client %s",
+			request.AccessKeyId, request.Os, request.Arch, request.Version)
+		return nil, status.Error(codes.Unauthenticated, "invalid access key id or secret access key")
+	}
+
+	token, expiredAt, err := b.tokens.IssueSessionToken(request.AccessKeyId)
+	if err != nil {
+		l.Errorln(err)
+		return nil, status.Error(codes.Internal, "unable issue session token")
+	}
+
+	l.Infof("access key %s logged in from %s/%s client %s, pid %d",
+		request.AccessKeyId, request.Os, request.Arch, request.Version, request.Pid)
+
+	return &v1.LoginResponse{Token: token, ExpiredAt: expiredAt.UTC().Format(time.RFC3339)}, nil
+}
+
+// NewBackendController create BackendController with the auth plugin, services
+// and tunnel options, the custom domains are disabled if domains is nil and
+// the bandwidth of the tunnels is not limited if limits is nil
+func NewBackendController(plugin auth.PluginInterface, tokens *service.TokenService, upstreams *service.UpstreamService,
+	certificates *service.CertificateService, domains *service.DomainService, limits *service.LimitService,
+	opts *tunnel.Options) *BackendController {
+	return &BackendController{
+		plugin:       plugin,
+		tokens:       tokens,
+		upstreams:    upstreams,
+		certificates: certificates,
+		domains:      domains,
+		limits:       limits,
+		opts:         opts,
+	}
 }
@@ -0,0 +1,1016 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/controller"
+	"github.com/aapelismith/kun/pkg/apiserver/middleware"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"github.com/aapelismith/kun/pkg/apiserver/server"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/auth"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/net/dns/dnsmessage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const pluginOptions = `
+	{
+		"credentials": [
+			{
+				"access_key_id": "admin",
+				"secret_access_key": "secret",
+				"domains": ["www.example.com", "*.dev.example.com", "*.customer.com"]
+			}
+		]
+	}`
+
+func newTokenService(t *testing.T) *service.TokenService {
+	opts := &config.TokenOptions{}
+	opts.SetDefaults()
+	opts.SigningKeys = []string{"0123456789abcdef0123456789abcdef"}
+
+	tokens, err := service.NewTokenService(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokens
+}
+
+func newPlugin(t *testing.T) auth.PluginInterface {
+	plugin, err := auth.NewPlugin("STATIC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := auth.PluginOptions(pluginOptions)
+	if err := plugin.Setup(context.Background(), &opts); err != nil {
+		t.Fatal(err)
+	}
+	return plugin
+}
+
+func newTunnelOptions() *tunnel.Options {
+	opts := tunnel.NewOptions()
+	opts.SetDefaults()
+	return opts
+}
+
+func newBackendController(t *testing.T, tokens *service.TokenService,
+	upstreams *service.UpstreamService) *controller.BackendController {
+	certificates := service.NewCertificateService(service.NewMemoryCertificateStore(), nil, nil)
+	return controller.NewBackendController(newPlugin(t), tokens, upstreams, certificates, nil, nil, newTunnelOptions())
+}
+
+func newLoginRequest(accessKeyId, secretAccessKey string) *v1.LoginRequest {
+	return &v1.LoginRequest{
+		Version:         "v0.0.1",
+		Os:              "linux",
+		Arch:            "amd64",
+		Pid:             1,
+		Timestamp:       time.Now().Unix(),
+		AccessKeyId:     accessKeyId,
+		SecretAccessKey: secretAccessKey,
+	}
+}
+
+func TestBackendController_Login(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	resp, err := c.Login(ctx, newLoginRequest("admin", "secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := resp.ValidateAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	expiredAt, err := time.Parse(time.RFC3339, resp.ExpiredAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !expiredAt.After(time.Now()) {
+		t.Fatalf("token already expired at %s", resp.ExpiredAt)
+	}
+
+	claims, err := tokens.ParseSessionToken(resp.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claims.Subject != "admin" {
+		t.Fatalf("unexpected subject %s", claims.Subject)
+	}
+
+	if _, err := c.Login(ctx, newLoginRequest("admin", "wrong")); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", err)
+	}
+
+	if _, err := c.Login(ctx, newLoginRequest("admin", "")); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+}
+
+type watchTunnelsServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *v1.WatchTunnelsResponse
+}
+
+func (s *watchTunnelsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchTunnelsServer) Send(resp *v1.WatchTunnelsResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+func newWatchTunnelsServer(ctx context.Context, tokens *service.TokenService, accessKeyId string) *watchTunnelsServer {
+	token, _, _ := tokens.IssueSessionToken(accessKeyId)
+	claims, _ := tokens.ParseSessionToken(token)
+
+	return &watchTunnelsServer{
+		ctx:       middleware.NewContext(ctx, claims),
+		responses: make(chan *v1.WatchTunnelsResponse, 16),
+	}
+}
+
+func TestBackendController_WatchTunnels(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	upstreams := service.NewUpstreamService(tokens, nil, nil, 0, "")
+	c := newBackendController(t, tokens, upstreams)
+
+	request := &v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", PoolSize: 2}
+
+	server := newWatchTunnelsServer(ctx, tokens, "admin")
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- c.WatchTunnels(request, server)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case resp := <-server.responses:
+			if err := resp.ValidateAll(); err != nil {
+				t.Fatal(err)
+			}
+
+			claims, err := tokens.ParseTunnelToken(resp.TunnelToken)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if claims.ID != resp.TraceId || claims.Hostname != request.Hostname || claims.Subject != "admin" {
+				t.Fatalf("unexpected tunnel token claims %+v", claims)
+			}
+		case err := <-errCh:
+			t.Fatal(err)
+		case <-time.After(time.Second):
+			t.Fatal("expected a tunnel token for every tunnel of the pool")
+		}
+	}
+
+	// the hostname is owned by the first watcher
+	other := newWatchTunnelsServer(ctx, tokens, "admin")
+	if err := c.WatchTunnels(request, other); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected already exists, got %v", err)
+	}
+
+	if _, err := upstreams.Get("A.dev.example.com:8080"); err != nil {
+		t.Fatal(err)
+	}
+
+	cancel()
+
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := upstreams.Get(request.Hostname); err == nil {
+		t.Fatal("expected the upstream removed when the watcher is gone")
+	}
+}
+
+func TestBackendController_WatchTunnelsPermissionDenied(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	request := &v1.WatchTunnelsRequest{Hostname: "www.google.com", Protocol: "HTTP"}
+
+	if err := c.WatchTunnels(request, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+}
+
+// recordingPlugin records the hostnames whose permission is checked
+type recordingPlugin struct {
+	auth.PluginInterface
+	hostnames []string
+}
+
+func (p *recordingPlugin) HasPermission(ctx context.Context, accessKeyId, hostname string) (bool, error) {
+	p.hostnames = append(p.hostnames, hostname)
+	return p.PluginInterface.HasPermission(ctx, accessKeyId, hostname)
+}
+
+func TestBackendController_WatchTunnelsNormalizeHostname(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	plugin := &recordingPlugin{PluginInterface: newPlugin(t)}
+	certificates := service.NewCertificateService(service.NewMemoryCertificateStore(), nil, nil)
+	c := controller.NewBackendController(plugin, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""),
+		certificates, nil, nil, newTunnelOptions())
+
+	request := &v1.WatchTunnelsRequest{Hostname: "WWW.Example.com.", Protocol: "HTTP", PoolSize: 1}
+	server := newWatchTunnelsServer(ctx, tokens, "admin")
+
+	go func() {
+		_ = c.WatchTunnels(request, server)
+	}()
+
+	select {
+	case resp := <-server.responses:
+		if resp.Hostname != "www.example.com" {
+			t.Fatalf("unexpected hostname %s", resp.Hostname)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token")
+	}
+
+	if len(plugin.hostnames) != 1 || plugin.hostnames[0] != "www.example.com" {
+		t.Fatalf("expected the permission checked on www.example.com, got %v", plugin.hostnames)
+	}
+}
+
+type connectTunnelServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *v1.TunnelMessage
+	recv chan *v1.TunnelMessage
+}
+
+func (s *connectTunnelServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectTunnelServer) Send(msg *v1.TunnelMessage) error {
+	select {
+	case s.sent <- msg:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *connectTunnelServer) Recv() (*v1.TunnelMessage, error) {
+	select {
+	case msg := <-s.recv:
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func newConnectTunnelServer(ctx context.Context, token string) *connectTunnelServer {
+	return &connectTunnelServer{
+		ctx:  metadata.NewIncomingContext(ctx, metadata.Pairs(tunnel.TokenKey, token)),
+		sent: make(chan *v1.TunnelMessage, 16),
+		recv: make(chan *v1.TunnelMessage, 16),
+	}
+}
+
+func TestBackendController_ConnectTunnel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	upstreams := service.NewUpstreamService(tokens, nil, nil, 0, "")
+	c := newBackendController(t, tokens, upstreams)
+
+	request := &v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", PoolSize: 1}
+	watcher := newWatchTunnelsServer(ctx, tokens, "admin")
+
+	go func() {
+		_ = c.WatchTunnels(request, watcher)
+	}()
+
+	var resp *v1.WatchTunnelsResponse
+	select {
+	case resp = <-watcher.responses:
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token")
+	}
+
+	if err := c.ConnectTunnel(newConnectTunnelServer(ctx, "")); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", err)
+	}
+
+	server := newConnectTunnelServer(ctx, resp.TunnelToken)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- c.ConnectTunnel(server)
+	}()
+
+	u, err := upstreams.Get(request.Hostname)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acquireCtx, acquireCancel := context.WithTimeout(ctx, time.Second)
+	defer acquireCancel()
+
+	conn, err := u.Pool().Acquire(acquireCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-server.sent:
+		if msg.Command != tunnel.CommandPush || string(msg.Payload) != "hello" {
+			t.Fatalf("unexpected message %s %q", msg.Command, msg.Payload)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the bytes pushed to the client")
+	}
+
+	// the token is used once
+	if err := c.ConnectTunnel(newConnectTunnelServer(ctx, resp.TunnelToken)); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", err)
+	}
+
+	server.recv <- &v1.TunnelMessage{Command: tunnel.CommandReset}
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected ConnectTunnel returned when the tunnel is reset")
+	}
+}
+
+func TestBackendController_UploadCertificate(t *testing.T) {
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	token, _, _ := tokens.IssueSessionToken("admin")
+	claims, _ := tokens.ParseSessionToken(token)
+	ctx := middleware.NewContext(context.Background(), claims)
+
+	request := &v1.UploadCertificateRequest{Hostname: "www.google.com", Certificate: "-", PrivateKey: "-"}
+	if _, err := c.UploadCertificate(ctx, request); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+
+	request = &v1.UploadCertificateRequest{Hostname: "*.dev.example.com", Certificate: "-", PrivateKey: "-"}
+	if _, err := c.UploadCertificate(ctx, request); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+
+	if _, err := c.UploadCertificate(context.Background(), request); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", err)
+	}
+}
+
+func TestBackendController_WatchTunnelsTCP(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	request := &v1.WatchTunnelsRequest{Hostname: "ssh.dev.example.com", Protocol: "TCP"}
+	if err := c.WatchTunnels(request, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected unimplemented without port range, got %v", err)
+	}
+
+	c = newBackendController(t, tokens, service.NewUpstreamService(tokens, service.NewPortAllocator("127.0.0.1", 1, 1), nil, 0, ""))
+
+	request.Port = 2
+	if err := c.WatchTunnels(request, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a port out of range, got %v", err)
+	}
+}
+
+func TestBackendController_WatchTunnelsCompression(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	request := &v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", Compression: []string{"brotli"}}
+	if err := c.WatchTunnels(request, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for an unknown codec, got %v", err)
+	}
+
+	for protocol, expected := range map[string]string{"HTTP": "snappy", "TLS": ""} {
+		request := &v1.WatchTunnelsRequest{
+			Hostname:    strings.ToLower(protocol) + ".dev.example.com",
+			Protocol:    protocol,
+			PoolSize:    1,
+			Compression: []string{"snappy", "gzip"},
+		}
+
+		server := newWatchTunnelsServer(ctx, tokens, "admin")
+
+		go func() {
+			_ = c.WatchTunnels(request, server)
+		}()
+
+		select {
+		case resp := <-server.responses:
+			if resp.Compression != expected {
+				t.Fatalf("expected codec %q negotiated for %s, got %q", expected, protocol, resp.Compression)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected a tunnel token")
+		}
+	}
+}
+
+func TestBackendController_WatchTunnelsWindowSize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	request := &v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", WindowSize: 1024}
+	if err := c.WatchTunnels(request, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a window below the initial window, got %v", err)
+	}
+
+	opts := newTunnelOptions()
+
+	for hostname, size := range map[string]int32{
+		"default.dev.example.com": 0,
+		"large.dev.example.com":   1024 * 1024,
+		"huge.dev.example.com":    math.MaxInt32,
+	} {
+		request := &v1.WatchTunnelsRequest{Hostname: hostname, Protocol: "HTTP", PoolSize: 1, WindowSize: size}
+
+		server := newWatchTunnelsServer(ctx, tokens, "admin")
+
+		go func() {
+			_ = c.WatchTunnels(request, server)
+		}()
+
+		select {
+		case resp := <-server.responses:
+			if expected := int32(opts.NegotiateWindowSize(int(size))); resp.WindowSize != expected {
+				t.Fatalf("expected window %d negotiated for %s, got %d", expected, hostname, resp.WindowSize)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected a tunnel token")
+		}
+	}
+}
+
+func TestBackendController_WatchTunnelsResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	upstreams := service.NewUpstreamService(tokens, nil, nil, time.Millisecond*300, "")
+	c := newBackendController(t, tokens, upstreams)
+
+	watch := func(request *v1.WatchTunnelsRequest) (*v1.WatchTunnelsResponse, context.CancelFunc, chan error) {
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		server := newWatchTunnelsServer(watchCtx, tokens, "admin")
+		errCh := make(chan error, 1)
+
+		go func() {
+			errCh <- c.WatchTunnels(request, server)
+		}()
+
+		select {
+		case resp := <-server.responses:
+			return resp, watchCancel, errCh
+		case err := <-errCh:
+			t.Fatal(err)
+		case <-time.After(time.Second):
+			t.Fatal("expected a tunnel token")
+		}
+		return nil, watchCancel, errCh
+	}
+
+	request := &v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", PoolSize: 1}
+
+	first, stop, errCh := watch(request)
+	if first.UpstreamId == "" {
+		t.Fatal("expected the id of the upstream")
+	}
+
+	stop()
+
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+
+	// the upstream waits for its client within the resume timeout
+	if _, err := upstreams.Get(request.Hostname); err != nil {
+		t.Fatal(err)
+	}
+
+	other := &v1.WatchTunnelsRequest{Hostname: request.Hostname, Protocol: "HTTP", ResumeId: "3b241101-e2bb-4255-8caf-4136c566a962"}
+	if err := c.WatchTunnels(other, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected already exists for a wrong resume id, got %v", err)
+	}
+
+	request.ResumeId = first.UpstreamId
+
+	resumed, stop, errCh := watch(request)
+	if resumed.UpstreamId != first.UpstreamId {
+		t.Fatalf("expected upstream %s resumed, got %s", first.UpstreamId, resumed.UpstreamId)
+	}
+
+	// a live watch is taken over, e.g. when the client noticed the drop first
+	_, stopAgain, errChAgain := watch(request)
+	defer stopAgain()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the previous watch stopped")
+	}
+	stop()
+
+	time.Sleep(time.Millisecond * 500)
+
+	if _, err := upstreams.Get(request.Hostname); err != nil {
+		t.Fatal("expected the upstream kept by the watch which took over")
+	}
+
+	stopAgain()
+
+	if err := <-errChAgain; err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Millisecond * 500)
+
+	if _, err := upstreams.Get(request.Hostname); err == nil {
+		t.Fatal("expected the upstream removed once the resume timeout elapsed")
+	}
+}
+
+func TestBackendController_GetUpstreamStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	invalid := &v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", MinPoolSize: 8, MaxPoolSize: 4}
+	if err := c.WatchTunnels(invalid, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for min pool size above max pool size, got %v", err)
+	}
+
+	request := &v1.WatchTunnelsRequest{
+		Hostname:    "a.dev.example.com",
+		Protocol:    "HTTP",
+		PoolSize:    64,
+		MinPoolSize: 1,
+		MaxPoolSize: 8,
+	}
+
+	server := newWatchTunnelsServer(ctx, tokens, "admin")
+
+	go func() {
+		_ = c.WatchTunnels(request, server)
+	}()
+
+	select {
+	case <-server.responses:
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token")
+	}
+
+	resp, err := c.GetUpstreamStats(server.ctx, &v1.GetUpstreamStatsRequest{Hostname: "A.dev.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the initial size is bounded by the max pool size
+	if resp.Hostname != request.Hostname || resp.PoolSize != 8 || resp.MinPoolSize != 1 || resp.MaxPoolSize != 8 {
+		t.Fatalf("unexpected stats %+v", resp)
+	}
+
+	if resp.Pending != 8 || resp.Idle != 0 || resp.AcquireWaitAvg.AsDuration() != 0 {
+		t.Fatalf("expected 8 tunnels requested, got %+v", resp)
+	}
+
+	_, err = c.GetUpstreamStats(server.ctx, &v1.GetUpstreamStatsRequest{Hostname: "b.dev.example.com"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestBackendController_ReportHealth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	upstreams := service.NewUpstreamService(tokens, nil, nil, 0, "")
+	c := newBackendController(t, tokens, upstreams)
+
+	request := &v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", PoolSize: 1}
+	server := newWatchTunnelsServer(ctx, tokens, "admin")
+
+	go func() {
+		_ = c.WatchTunnels(request, server)
+	}()
+
+	var upstreamId string
+	select {
+	case resp := <-server.responses:
+		upstreamId = resp.UpstreamId
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token")
+	}
+
+	report := &v1.ReportHealthRequest{Hostname: "a.dev.example.com", UpstreamId: upstreamId, Reason: "connection refused"}
+
+	resp, err := c.ReportHealth(server.ctx, report)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Status != model.UpstreamStatusUnhealthy {
+		t.Fatalf("expected status %s, got %s", model.UpstreamStatusUnhealthy, resp.Status)
+	}
+
+	if _, err := upstreams.Pick("a.dev.example.com", "/"); !errors.Is(err, service.ErrUpstreamUnhealthy) {
+		t.Fatalf("expected no upstream picked, got %v", err)
+	}
+
+	report.Healthy = true
+
+	resp, err = c.ReportHealth(server.ctx, report)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Status != model.UpstreamStatusActive {
+		t.Fatalf("expected status %s, got %s", model.UpstreamStatusActive, resp.Status)
+	}
+
+	report.UpstreamId = "5f0c5b8e-3a43-4a8b-9a4b-8cbb2b1d7e41"
+	if _, err := c.ReportHealth(server.ctx, report); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found for an unknown upstream, got %v", err)
+	}
+
+	other := newWatchTunnelsServer(ctx, tokens, "other")
+	report.UpstreamId = upstreamId
+	if _, err := c.ReportHealth(other.ctx, report); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found for another access key, got %v", err)
+	}
+}
+
+func TestBackendController_WatchTunnelsWildcard(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	for hostname, code := range map[string]codes.Code{
+		"*.example.com":      codes.PermissionDenied,
+		"a.*.example.com":    codes.InvalidArgument,
+		"":                   codes.InvalidArgument,
+		"**.dev.example.com": codes.InvalidArgument,
+	} {
+		request := &v1.WatchTunnelsRequest{Hostname: hostname, Protocol: "HTTP"}
+
+		if err := c.WatchTunnels(request, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != code {
+			t.Fatalf("expected %s for %q, got %v", code, hostname, err)
+		}
+	}
+
+	request := &v1.WatchTunnelsRequest{Hostname: "*.dev.example.com", Protocol: "HTTP", PoolSize: 1}
+	server := newWatchTunnelsServer(ctx, tokens, "admin")
+
+	go func() {
+		_ = c.WatchTunnels(request, server)
+	}()
+
+	select {
+	case resp := <-server.responses:
+		if resp.Hostname != "*.dev.example.com" {
+			t.Fatalf("unexpected hostname %s", resp.Hostname)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token")
+	}
+}
+
+func TestBackendController_WatchTunnelsRandomSubdomain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, "tunnel.example.com"))
+
+	// the subdomains of the base domain are allocated without permission
+	server := newWatchTunnelsServer(ctx, tokens, "admin")
+
+	go func() {
+		_ = c.WatchTunnels(&v1.WatchTunnelsRequest{Protocol: "HTTP", PoolSize: 1}, server)
+	}()
+
+	select {
+	case resp := <-server.responses:
+		if !strings.HasSuffix(resp.Hostname, ".tunnel.example.com") {
+			t.Fatalf("unexpected hostname %s", resp.Hostname)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token")
+	}
+}
+
+// serveTXT answers the TXT queries of the DNS clients on conn with the
+// records returned by txt, and every other query with no such name
+func serveTXT(conn net.PacketConn, txt func(name string) []string) {
+	buf := make([]byte, 512)
+	for {
+		size, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		var request dnsmessage.Message
+		if err := request.Unpack(buf[:size]); err != nil || len(request.Questions) == 0 {
+			continue
+		}
+
+		q := request.Questions[0]
+		reply := dnsmessage.Message{
+			Header:    dnsmessage.Header{ID: request.ID, Response: true, RCode: dnsmessage.RCodeNameError},
+			Questions: request.Questions,
+		}
+
+		if values := txt(q.Name.String()); q.Type == dnsmessage.TypeTXT && len(values) > 0 {
+			reply.RCode = dnsmessage.RCodeSuccess
+			for _, value := range values {
+				reply.Answers = append(reply.Answers, dnsmessage.Resource{
+					Header: dnsmessage.ResourceHeader{Name: q.Name, Type: q.Type, Class: q.Class, TTL: 60},
+					Body:   &dnsmessage.TXTResource{TXT: []string{value}},
+				})
+			}
+		}
+
+		if packed, err := reply.Pack(); err == nil {
+			_, _ = conn.WriteTo(packed, addr)
+		}
+	}
+}
+
+func TestBackendController_ClaimDomain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var records atomic.Value
+	records.Store([]string{})
+
+	go serveTXT(conn, func(name string) []string {
+		if name != "_kun-challenge.app.customer.com." {
+			return nil
+		}
+		return records.Load().([]string)
+	})
+
+	opts := &config.DomainOptions{}
+	opts.SetDefaults()
+	opts.Enabled = true
+	opts.Nameserver = conn.LocalAddr().String()
+
+	tokens := newTokenService(t)
+	upstreams := service.NewUpstreamService(tokens, nil, nil, 0, "")
+	certificates := service.NewCertificateService(service.NewMemoryCertificateStore(), nil, nil)
+	c := controller.NewBackendController(newPlugin(t), tokens, upstreams, certificates,
+		service.NewDomainService(opts, "example.com"), nil, newTunnelOptions())
+
+	token, _, _ := tokens.IssueSessionToken("admin")
+	claims, _ := tokens.ParseSessionToken(token)
+	claimCtx := middleware.NewContext(ctx, claims)
+
+	if _, err := c.ClaimDomain(claimCtx, &v1.ClaimDomainRequest{Hostname: "*.customer.com"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+
+	claim, err := c.ClaimDomain(claimCtx, &v1.ClaimDomainRequest{Hostname: "app.customer.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claim.Status != model.DomainStatusPending || claim.TxtName != "_kun-challenge.app.customer.com" ||
+		claim.TxtValue == "" || claim.CnameTarget != "" || claim.ExpiredAt == "" {
+		t.Fatalf("unexpected claim %+v", claim)
+	}
+
+	request := &v1.WatchTunnelsRequest{Hostname: "app.customer.com", Protocol: "HTTP", PoolSize: 1}
+
+	// the custom domain allowed by the auth plugin must be verified still
+	if err := c.WatchTunnels(request, newWatchTunnelsServer(ctx, tokens, "admin")); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied before verification, got %v", err)
+	}
+
+	// the hostnames of the base domain are allowed by the auth plugin
+	allowed := newWatchTunnelsServer(ctx, tokens, "admin")
+	go func() {
+		_ = c.WatchTunnels(&v1.WatchTunnelsRequest{Hostname: "www.example.com", Protocol: "HTTP", PoolSize: 1}, allowed)
+	}()
+
+	select {
+	case <-allowed.responses:
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token for a hostname of the base domain")
+	}
+
+	verify := &v1.VerifyDomainRequest{Hostname: "app.customer.com"}
+	if _, err := c.VerifyDomain(claimCtx, verify); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", err)
+	}
+
+	records.Store([]string{claim.TxtValue})
+
+	verified, err := c.VerifyDomain(claimCtx, verify)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if verified.Status != model.DomainStatusVerified || verified.Method != model.VerificationTXT {
+		t.Fatalf("unexpected verified domain %+v", verified)
+	}
+
+	server := newWatchTunnelsServer(ctx, tokens, "admin")
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- c.WatchTunnels(request, server)
+	}()
+
+	select {
+	case resp := <-server.responses:
+		if resp.Hostname != "app.customer.com" {
+			t.Fatalf("unexpected hostname %s", resp.Hostname)
+		}
+	case err := <-errCh:
+		t.Fatal(err)
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token once the domain is verified")
+	}
+
+	disabled := newBackendController(t, tokens, upstreams)
+	if _, err := disabled.ClaimDomain(claimCtx, &v1.ClaimDomainRequest{Hostname: "app.customer.com"}); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected unimplemented without domain service, got %v", err)
+	}
+}
+
+func TestBackendController_Gateway(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t)
+	c := newBackendController(t, tokens, service.NewUpstreamService(tokens, nil, nil, 0, ""))
+
+	authenticator := middleware.NewAuth(tokens, "/"+v1.BackendController_ServiceDesc.ServiceName+"/Login")
+	local := server.NewLocalConn(&v1.BackendController_ServiceDesc, c, authenticator.UnaryServerInterceptor())
+
+	gateway := runtime.NewServeMux()
+	if err := v1.RegisterBackendControllerHandlerClient(ctx, gateway, v1.NewBackendControllerClient(local)); err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(gateway)
+	defer srv.Close()
+
+	body, err := protojson.Marshal(newLoginRequest("admin", "secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Post(srv.URL+"/v1/login", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected login to succeed, got %d: %s", resp.StatusCode, data)
+	}
+
+	login := &v1.LoginResponse{}
+	if err := protojson.Unmarshal(data, login); err != nil {
+		t.Fatal(err)
+	}
+
+	watch := newWatchTunnelsServer(ctx, tokens, "admin")
+	go func() {
+		_ = c.WatchTunnels(&v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", PoolSize: 1}, watch)
+	}()
+
+	select {
+	case <-watch.responses:
+	case <-time.After(time.Second):
+		t.Fatal("expected a tunnel token")
+	}
+
+	stats := func(token string) (int, []byte) {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/v1/upstreams/a.dev.example.com/stats", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, data
+	}
+
+	if code, data := stats(login.Token); code != http.StatusOK {
+		t.Fatalf("expected the authenticated call to succeed, got %d: %s", code, data)
+	}
+
+	if code, _ := stats(""); code != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized without token, got %d", code)
+	}
+}
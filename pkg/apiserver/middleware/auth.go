@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// AuthorizationKey the metadata key carrying the session token
+const AuthorizationKey = "authorization"
+
+type claimsKey struct{}
+
+// NewContext create new context with the claims of the session token
+func NewContext(ctx context.Context, claims *service.Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext get the claims of the session token from the context
+func FromContext(ctx context.Context) (*service.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*service.Claims)
+	return claims, ok
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// Auth verifies the session token of every call except the public
+// methods, and stores its claims in the context of the call
+type Auth struct {
+	tokens *service.TokenService
+	public map[string]bool
+}
+
+// authenticate returns the context carrying the claims of the session token
+func (a *Auth) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if a.public[method] {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(AuthorizationKey)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	token := strings.TrimSpace(values[0])
+	if len(token) > 7 && strings.EqualFold(token[:7], "bearer ") {
+		token = strings.TrimSpace(token[7:])
+	}
+
+	claims, err := a.tokens.ParseSessionToken(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return NewContext(ctx, claims), nil
+}
+
+// UnaryServerInterceptor authenticates unary calls
+func (a *Auth) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates streaming calls
+func (a *Auth) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// NewAuth create Auth which lets the calls of publicMethods pass without token
+func NewAuth(tokens *service.TokenService, publicMethods ...string) *Auth {
+	public := make(map[string]bool, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = true
+	}
+	return &Auth{tokens: tokens, public: public}
+}
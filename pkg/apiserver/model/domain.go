@@ -0,0 +1,48 @@
+/*
+ * Copyright 2021 Aapeli.Smith<aapeli.nian@gmail.com>.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+const (
+	// DomainStatusPending the domain is claimed, its ownership is not proven yet
+	DomainStatusPending = "PENDING"
+	// DomainStatusVerified the ownership of the domain is proven, the upstreams
+	// of the access key may use it
+	DomainStatusVerified = "VERIFIED"
+)
+
+const (
+	// VerificationTXT the ownership is proven by a TXT record holding the token
+	VerificationTXT = "TXT"
+	// VerificationCNAME the ownership is proven by a CNAME record of the domain
+	// pointing at the token under the hostname of the edge
+	VerificationCNAME = "CNAME"
+)
+
+// Domain a custom domain claimed by an access key, the DomainName of an
+// Upstream outside the base domain of the server must be a verified Domain of
+// the access key watching it, whatever the auth plugin allows
+type Domain struct {
+	Hostname    string    `json:"hostname,omitempty"`
+	Status      string    `json:"status,omitempty"`
+	AccessKeyId string    `json:"accessKeyId,omitempty"`
+	Token       string    `json:"token,omitempty"`
+	Method      string    `json:"method,omitempty"`
+	CreatedAt   time.Time `json:"createdAt,omitempty"`
+	ExpiredAt   time.Time `json:"expiredAt,omitempty"`
+	VerifiedAt  time.Time `json:"verifiedAt,omitempty"`
+}
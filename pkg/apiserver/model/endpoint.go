@@ -17,11 +17,79 @@ import (
 	"time"
 )
 
+const (
+	// UpstreamStatusActive the upstream is watched by a client
+	UpstreamStatusActive = "ACTIVE"
+	// UpstreamStatusUnhealthy the local service of the upstream fails its
+	// health check, no request is routed to it
+	UpstreamStatusUnhealthy = "UNHEALTHY"
+)
+
+const (
+	// ProtocolHTTP the upstream is served over http by the frontend
+	ProtocolHTTP = "HTTP"
+	// ProtocolHTTPS the upstream is served over https by the frontend
+	ProtocolHTTPS = "HTTPS"
+	// ProtocolTLS the tls connections of the upstream are forwarded as is by
+	// the https frontend, which routes them by server name
+	ProtocolTLS = "TLS"
+	// ProtocolTCP the connections to a public port are forwarded to the upstream
+	ProtocolTCP = "TCP"
+	// ProtocolUDP the datagrams to a public port are forwarded to the upstream,
+	// one tunnel for every source address
+	ProtocolUDP = "UDP"
+)
+
+const (
+	// LoadBalancingRoundRobin the clients of a hostname take turns
+	LoadBalancingRoundRobin = "ROUND_ROBIN"
+	// LoadBalancingLeastConnections the client with the fewest tunnels in use is chosen
+	LoadBalancingLeastConnections = "LEAST_CONNECTIONS"
+	// LoadBalancingWeighted the clients take turns in proportion to their weight
+	LoadBalancingWeighted = "WEIGHTED"
+)
+
+const (
+	// HeaderActionAdd the value is appended to the header
+	HeaderActionAdd = "ADD"
+	// HeaderActionSet the values of the header are replaced by the value
+	HeaderActionSet = "SET"
+	// HeaderActionRemove the header is deleted
+	HeaderActionRemove = "REMOVE"
+	// HeaderActionReplace the matches of the pattern are replaced by the
+	// value in every value of the header
+	HeaderActionReplace = "REPLACE"
+)
+
+// HeaderRule a rewrite of a header of the requests or responses of an upstream
+type HeaderRule struct {
+	Action  string `json:"action,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
 type Upstream struct {
-	ID         string    `json:"id,omitempty"`
-	Status     string    `json:"status,omitempty"`
-	NodeID     string    `json:"nodeId,omitempty"`
-	DomainName string    `json:"domainName,omitempty"`
-	CreatedAt  time.Time `json:"createdAt,omitempty"`
-	UpdatedAt  time.Time `json:"updatedAt,omitempty"`
+	ID              string       `json:"id,omitempty"`
+	Status          string       `json:"status,omitempty"`
+	NodeID          string       `json:"nodeId,omitempty"`
+	DomainName      string       `json:"domainName,omitempty"`
+	Protocol        string       `json:"protocol,omitempty"`
+	Port            int32        `json:"port,omitempty"`
+	HTTP2           bool         `json:"http2,omitempty"`
+	Multiplex       bool         `json:"multiplex,omitempty"`
+	Compression     string       `json:"compression,omitempty"`
+	WindowSize      int32        `json:"windowSize,omitempty"`
+	LoadBalancing   string       `json:"loadBalancing,omitempty"`
+	Weight          int32        `json:"weight,omitempty"`
+	PathPrefix      string       `json:"pathPrefix,omitempty"`
+	StripPrefix     bool         `json:"stripPrefix,omitempty"`
+	RewritePrefix   string       `json:"rewritePrefix,omitempty"`
+	RequestHeaders  []HeaderRule `json:"requestHeaders,omitempty"`
+	ResponseHeaders []HeaderRule `json:"responseHeaders,omitempty"`
+	AllowCIDRs      []string     `json:"allowCidrs,omitempty"`
+	DenyCIDRs       []string     `json:"denyCidrs,omitempty"`
+	AccessKeyId     string       `json:"accessKeyId,omitempty"`
+	CreatedAt       time.Time    `json:"createdAt,omitempty"`
+	UpdatedAt       time.Time    `json:"updatedAt,omitempty"`
 }
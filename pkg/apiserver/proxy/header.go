@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// setForwardedHeaders tells the local service how r reached the frontend in
+// X-Forwarded-Host, X-Forwarded-Proto and the RFC 7239 Forwarded header,
+// which is appended to the one of the previous proxies if the peer of r is
+// one of trustedProxies. The Forwarded and X-Forwarded-For headers of any
+// other peer are dropped, so that a client cannot spoof its origin.
+// X-Forwarded-For is appended by the reverse proxy, or by appendForwardedFor.
+func setForwardedHeaders(r *http.Request, trustedProxies []*net.IPNet) {
+	if ip := net.ParseIP(clientIP(r)); ip == nil || !service.ContainsIP(trustedProxies, ip) {
+		r.Header.Del("Forwarded")
+		r.Header.Del("X-Forwarded-For")
+	}
+
+	proto := "http"
+	if r.TLS != nil {
+		proto = "https"
+	}
+
+	r.Header.Set("X-Forwarded-Host", r.Host)
+	r.Header.Set("X-Forwarded-Proto", proto)
+
+	element := "host=" + quoteForwarded(r.Host) + ";proto=" + proto
+	if ip := clientIP(r); ip != "" {
+		node := ip
+		if strings.Contains(ip, ":") {
+			node = "[" + ip + "]"
+		}
+		element = "for=" + quoteForwarded(node) + ";" + element
+	}
+
+	if prior := r.Header.Values("Forwarded"); len(prior) > 0 {
+		element = strings.Join(prior, ", ") + ", " + element
+	}
+	r.Header.Set("Forwarded", element)
+}
+
+// appendForwardedFor appends the address of the client of r to the
+// X-Forwarded-For header of the previous proxies
+func appendForwardedFor(r *http.Request) {
+	ip := clientIP(r)
+	if ip == "" {
+		return
+	}
+
+	if prior := r.Header.Values("X-Forwarded-For"); len(prior) > 0 {
+		ip = strings.Join(prior, ", ") + ", " + ip
+	}
+	r.Header.Set("X-Forwarded-For", ip)
+}
+
+// clientIP returns the ip address of the peer of r, empty if unknown
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+// quoteForwarded returns v as a token, or a quoted string if it contains
+// characters which are not allowed in a token of the Forwarded header
+func quoteForwarded(v string) string {
+	for _, c := range v {
+		if !isTokenChar(c) {
+			return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"`
+		}
+	}
+	return v
+}
+
+// isTokenChar reports whether c is allowed in a token of RFC 7230
+func isTokenChar(c rune) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	default:
+		return strings.ContainsRune("!#$%&'*+-.^_`|~", c)
+	}
+}
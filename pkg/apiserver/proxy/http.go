@@ -0,0 +1,384 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"golang.org/x/net/http2"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// idleConnTimeout the maximum duration a tunnel is kept open between requests
+	idleConnTimeout = time.Second * 90
+
+	// maintenanceRetryAfter the seconds a client is told to wait before
+	// retrying a hostname whose upstreams are all unhealthy
+	maintenanceRetryAfter = "30"
+)
+
+// HTTPProxy forwards the requests to the upstream watching their Host and
+// the longest path prefix of their path through the tunnels of its pool,
+// over HTTP/2 if the local service of the upstream speaks h2c, so that gRPC
+// trailers and streams are kept intact. The requests of the clients refused
+// by the access policy of the upstream are answered 403, those over the rate
+// limit of the hostname or its access key 429, those without the credentials
+// required by the gate of the upstream 401.
+type HTTPProxy struct {
+	upstreams      *service.UpstreamService
+	limits         *service.LimitService
+	gates          *service.GateService
+	trustedProxies []*net.IPNet
+	proxy          *httputil.ReverseProxy
+	transport      *http.Transport
+	h2Transport    *http2.Transport
+}
+
+// ServeHTTP implements http.Handler
+func (p *HTTPProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	u, err := p.upstreams.Match(r.Host, r.URL.Path)
+	if err != nil || (u.Protocol != model.ProtocolHTTP && u.Protocol != model.ProtocolHTTPS &&
+		u.Protocol != model.ProtocolTLS) {
+		// the tunnels of TCP and UDP upstreams carry no http
+		WriteErrorPage(w, http.StatusNotFound, "No tunnel is connected for "+service.NormalizeHostname(r.Host)+".")
+		return
+	}
+
+	if !u.Allowed(service.ClientIP(r.RemoteAddr, r.Header.Values("X-Forwarded-For"), p.trustedProxies)) {
+		WriteErrorPage(w, http.StatusForbidden, "Access to "+service.NormalizeHostname(r.Host)+" is denied.")
+		return
+	}
+
+	if healthy, err := p.upstreams.Healthy(r.Host, r.URL.Path); err == nil && !healthy {
+		writeMaintenancePage(w, r)
+		return
+	}
+
+	if p.limits != nil {
+		if ok, delay := p.limits.AllowRequest(u.DomainName, u.AccessKeyId); !ok {
+			writeTooManyRequestsPage(w, r, delay)
+			return
+		}
+	}
+
+	if ok, challenges := p.gates.Authorize(u, r); !ok {
+		writeUnauthorizedPage(w, r, challenges)
+		return
+	}
+
+	if u.Protocol == model.ProtocolTLS && r.TLS == nil {
+		// the upstream accepts tls connections only, they are passed through
+		// by the https frontend
+		target := url.URL{
+			Scheme:   "https",
+			Host:     service.NormalizeHostname(r.Host),
+			Path:     r.URL.Path,
+			RawQuery: r.URL.RawQuery,
+		}
+		http.Redirect(w, r, target.String(), http.StatusPermanentRedirect)
+		return
+	}
+
+	if isUpgrade(r) {
+		p.serveUpgrade(w, r)
+		return
+	}
+	p.proxy.ServeHTTP(w, r)
+}
+
+// Close closes the tunnels kept open between requests
+func (p *HTTPProxy) Close() error {
+	p.transport.CloseIdleConnections()
+	p.h2Transport.CloseIdleConnections()
+	return nil
+}
+
+// dial takes a tunnel out of the pool of the upstream addr is routed to by
+// director. The transports keep the tunnel between requests, it is counted
+// as in use only while roundTrip holds it for a request.
+func (p *HTTPProxy) dial(ctx context.Context, _, addr string) (net.Conn, error) {
+	u, err := p.lookup(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := acquireFrom(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Pool().Release(conn)
+	return conn, nil
+}
+
+// lookup returns the upstream addr is routed to by director, the id of the
+// upstream followed by its hostname
+func (p *HTTPProxy) lookup(addr string) (*service.Upstream, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	id, hostname, _ := strings.Cut(host, ".")
+	return p.upstreams.Lookup(hostname, id)
+}
+
+// dialH2C takes a tunnel for the h2c connection of the upstream addr is
+// routed to, no tls is spoken over the tunnel
+func (p *HTTPProxy) dialH2C(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+	return p.dial(ctx, network, addr)
+}
+
+// roundTrip sends r over HTTP/2 to the upstreams speaking h2c, over
+// HTTP/1.1 to the others. The tunnel carrying r is held in its pool until
+// the response is read. The tunnel of an HTTP/1.1 request does not
+// compress a body which is compressed already.
+func (p *HTTPProxy) roundTrip(r *http.Request) (*http.Response, error) {
+	u, err := p.lookup(r.URL.Host)
+	if err != nil {
+		return p.transport.RoundTrip(r)
+	}
+
+	transport := http.RoundTripper(p.transport)
+	if u.HTTP2 {
+		transport = p.h2Transport
+	}
+
+	compress := compressible(r.Header)
+
+	var (
+		mu   sync.Mutex
+		held net.Conn
+	)
+
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if c, ok := info.Conn.(compressor); ok && !u.HTTP2 {
+				c.SetCompress(compress)
+			}
+
+			u.Pool().Hold(info.Conn)
+
+			mu.Lock()
+			held = info.Conn
+			mu.Unlock()
+		},
+	}
+
+	resp, err := transport.RoundTrip(r.WithContext(httptrace.WithClientTrace(r.Context(), trace)))
+
+	mu.Lock()
+	conn := held
+	mu.Unlock()
+
+	if conn == nil {
+		return resp, err
+	}
+
+	release := func() {
+		u.Pool().Release(conn)
+	}
+
+	switch {
+	case err != nil:
+		release()
+	case resp.StatusCode == http.StatusSwitchingProtocols:
+		// the upgraded tunnel is in use until it is closed
+	default:
+		resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+	}
+	return resp, err
+}
+
+// releaseBody releases the tunnel of a response once its body is closed
+type releaseBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close implements io.Closer
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// director routes the request to the upstream of its Host and path chosen
+// by the load balancing policy. The upstream is named by the host of the
+// url, so that the transports keep the tunnels of every upstream apart
+// between requests, the Host header is kept unless the header rules of the
+// upstream rewrite it.
+func (p *HTTPProxy) director(r *http.Request) {
+	r.URL.Scheme = "http"
+	r.URL.Host = r.Host
+
+	setForwardedHeaders(r, p.trustedProxies)
+
+	if u, err := p.upstreams.Pick(r.Host, r.URL.Path); err == nil {
+		// the domain of a wildcard upstream is no hostname
+		r.URL.Host = u.ID + "." + service.NormalizeHostname(r.Host)
+		rewritePath(r, u)
+		u.RewriteRequest(r)
+	}
+
+	if _, ok := r.Header["User-Agent"]; !ok {
+		// explicitly disable the default User-Agent of the transport
+		r.Header.Set("User-Agent", "")
+	}
+}
+
+// modifyResponse rewrites the headers of a response by the rules of the
+// upstream its request was routed to
+func (p *HTTPProxy) modifyResponse(resp *http.Response) error {
+	if u, err := p.lookup(resp.Request.URL.Host); err == nil {
+		u.RewriteResponse(resp)
+	}
+	return nil
+}
+
+// errorHandler replies a request which could not be forwarded
+func (p *HTTPProxy) errorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	l := log.FromContext(r.Context()).Sugar()
+
+	switch {
+	case errors.Is(err, service.ErrUpstreamNotFound), errors.Is(err, service.ErrPoolClosed):
+		WriteErrorPage(w, http.StatusNotFound, "No tunnel is connected for "+service.NormalizeHostname(r.Host)+".")
+	case errors.Is(err, service.ErrUpstreamUnhealthy):
+		writeMaintenancePage(w, r)
+	case errors.Is(err, context.Canceled):
+		// the client has gone away
+		w.WriteHeader(http.StatusBadGateway)
+	default:
+		l.Warnf("unable forward %s %s%s, got: %v", r.Method, r.Host, r.URL.Path, err)
+		WriteErrorPage(w, http.StatusBadGateway, "The tunnel of "+service.NormalizeHostname(r.Host)+
+			" is unavailable.")
+	}
+}
+
+// NewHTTPProxy create HTTPProxy forwarding requests to upstreams within the
+// rate limits of limits, the requests are not limited if it is nil. The
+// clients of an upstream are required the credentials of gates, or those of
+// the upstream if it is nil. The X-Forwarded-For header of the requests from
+// trustedProxies tells the address of the client checked against the access
+// policy of an upstream, the forwarding headers of the other peers are dropped.
+func NewHTTPProxy(upstreams *service.UpstreamService, limits *service.LimitService, gates *service.GateService,
+	trustedProxies []*net.IPNet) *HTTPProxy {
+	p := &HTTPProxy{upstreams: upstreams, limits: limits, gates: gates, trustedProxies: trustedProxies}
+
+	p.transport = &http.Transport{
+		DialContext:         p.dial,
+		MaxIdleConnsPerHost: 32,
+		IdleConnTimeout:     idleConnTimeout,
+		DisableCompression:  true,
+	}
+
+	// the connection of an h2c upstream carries all of its requests
+	p.h2Transport = &http2.Transport{
+		AllowHTTP:          true,
+		DialTLSContext:     p.dialH2C,
+		DisableCompression: true,
+	}
+
+	p.proxy = &httputil.ReverseProxy{
+		Director:       p.director,
+		Transport:      roundTripperFunc(p.roundTrip),
+		FlushInterval:  -1,
+		ModifyResponse: p.modifyResponse,
+		ErrorHandler:   p.errorHandler,
+	}
+	return p
+}
+
+// writeMaintenancePage replies a request whose upstreams are all unhealthy
+func writeMaintenancePage(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Retry-After", maintenanceRetryAfter)
+	WriteErrorPage(w, http.StatusServiceUnavailable, service.NormalizeHostname(r.Host)+
+		" is under maintenance, please try again later.")
+}
+
+// writeTooManyRequestsPage replies a request over the rate limit, which may
+// be retried after delay
+func writeTooManyRequestsPage(w http.ResponseWriter, r *http.Request, delay time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+	WriteErrorPage(w, http.StatusTooManyRequests, "Too many requests to "+service.NormalizeHostname(r.Host)+
+		", please try again later.")
+}
+
+// writeUnauthorizedPage replies a request without the credentials required by
+// the gate of its upstream, which answer challenges
+func writeUnauthorizedPage(w http.ResponseWriter, r *http.Request, challenges []string) {
+	for _, challenge := range challenges {
+		w.Header().Add("WWW-Authenticate", challenge)
+	}
+	WriteErrorPage(w, http.StatusUnauthorized, "Credentials are required to access "+
+		service.NormalizeHostname(r.Host)+".")
+}
+
+// rewritePath replaces the path prefix of u in the path of r by the rewrite
+// of u, or strips it, the original prefix is passed in X-Forwarded-Prefix
+func rewritePath(r *http.Request, u *service.Upstream) {
+	if !u.StripPrefix && u.RewritePrefix == "" {
+		return
+	}
+
+	replace := func(path string) string {
+		if path = u.RewritePrefix + strings.TrimPrefix(path, u.PathPrefix); !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		return path
+	}
+
+	// the prefixes are made of characters which are never escaped
+	r.URL.Path = replace(r.URL.Path)
+	if r.URL.RawPath != "" {
+		r.URL.RawPath = replace(r.URL.RawPath)
+	}
+
+	if u.PathPrefix != "" {
+		r.Header.Set("X-Forwarded-Prefix", u.PathPrefix)
+	}
+}
+
+// compressible reports whether the body described by h is worth compressing
+func compressible(h http.Header) bool {
+	if encoding := h.Get("Content-Encoding"); encoding != "" && !strings.EqualFold(encoding, "identity") {
+		return false
+	}
+	return tunnel.Compressible(h.Get("Content-Type"))
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy_test
+
+import (
+	"bufio"
+	"context"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPProxy_HTTP2(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the local h2c service echoes every line of a bidirectional stream,
+	// then reports its status in the trailers like gRPC
+	local := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 || r.Header.Get("Te") != "trailers" {
+			w.WriteHeader(http.StatusHTTPVersionNotSupported)
+			return
+		}
+
+		w.Header().Set("Trailer", "Grpc-Status")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+
+		lines := bufio.NewScanner(r.Body)
+		for lines.Scan() {
+			_, _ = io.WriteString(w, lines.Text()+"\n")
+			w.(http.Flusher).Flush()
+		}
+
+		w.Header().Set("Grpc-Status", "0")
+	}), &http2.Server{}))
+	defer local.Close()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	request := &v1.WatchTunnelsRequest{Hostname: "grpc.example.com", Protocol: "HTTPS", PoolSize: 1, Http2: true}
+	watchRequest(ctx, t, upstreams, request, local.Listener.Addr().String())
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewUnstartedServer(p)
+	frontend.EnableHTTP2 = true
+	frontend.StartTLS()
+	defer frontend.Close()
+
+	body, bodyWriter := io.Pipe()
+
+	req, err := http.NewRequest(http.MethodPost, frontend.URL+"/echo.Echo/Stream", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "grpc.example.com"
+	req.Header.Set("Te", "trailers")
+
+	resp, err := frontend.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || resp.ProtoMajor != 2 {
+		t.Fatalf("unexpected response %d over %s", resp.StatusCode, resp.Proto)
+	}
+
+	// every message is answered before the next one is sent
+	lines := bufio.NewReader(resp.Body)
+	for _, msg := range []string{"ping 1", "ping 2"} {
+		if _, err := io.WriteString(bodyWriter, msg+"\n"); err != nil {
+			t.Fatal(err)
+		}
+
+		line, err := lines.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if line != msg+"\n" {
+			t.Fatalf("unexpected message %q", line)
+		}
+	}
+	_ = bodyWriter.Close()
+
+	if _, err := io.ReadAll(lines); err != nil {
+		t.Fatal(err)
+	}
+
+	if status := resp.Trailer.Get("Grpc-Status"); status != "0" {
+		t.Fatalf("unexpected trailers %v", resp.Trailer)
+	}
+}
@@ -0,0 +1,955 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stream one side of an in-memory ConnectTunnel stream
+type stream struct {
+	ctx  context.Context
+	send chan<- *v1.TunnelMessage
+	recv <-chan *v1.TunnelMessage
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) Send(msg *v1.TunnelMessage) error {
+	select {
+	case s.send <- msg:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *stream) Recv() (*v1.TunnelMessage, error) {
+	select {
+	case msg := <-s.recv:
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func newTokenService(t *testing.T) *service.TokenService {
+	opts := &config.TokenOptions{}
+	opts.SetDefaults()
+	opts.SigningKeys = []string{"0123456789abcdef0123456789abcdef"}
+
+	tokens, err := service.NewTokenService(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokens
+}
+
+// serveTunnel plays the client of a tunnel, it connects addr once the
+// tunnel is opened and copies the bytes in both directions
+func serveTunnel(conn *tunnel.Conn, addr string) {
+	defer conn.Close()
+
+	select {
+	case <-conn.Opened():
+	case <-conn.Done():
+		return
+	}
+
+	local, err := net.Dial("tcp", addr)
+	if err != nil {
+		return
+	}
+	defer local.Close()
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(local, conn)
+		_ = local.(*net.TCPConn).CloseWrite()
+		close(done)
+	}()
+
+	_, _ = io.Copy(conn, local)
+	_ = conn.CloseWrite()
+	<-done
+}
+
+// watch registers hostname and connects the tunnels requested by its pool
+// to the local service at addr
+func watch(ctx context.Context, t *testing.T, upstreams *service.UpstreamService, hostname, protocol, addr string) {
+	watchRequest(ctx, t, upstreams, &v1.WatchTunnelsRequest{Hostname: hostname, Protocol: protocol, PoolSize: 1}, addr)
+}
+
+// watchRequest registers the upstream of request and connects the tunnels
+// requested by its pool to the local service at addr
+func watchRequest(ctx context.Context, t *testing.T, upstreams *service.UpstreamService,
+	request *v1.WatchTunnelsRequest, addr string) {
+	opts := tunnel.NewOptions()
+	opts.SetDefaults()
+
+	hostname := request.Hostname
+
+	send := func(resp *v1.WatchTunnelsResponse) error {
+		u, err := upstreams.Lookup(hostname, resp.UpstreamId)
+		if err != nil {
+			return err
+		}
+
+		a, b := make(chan *v1.TunnelMessage, 16), make(chan *v1.TunnelMessage, 16)
+
+		// both sides compress with the negotiated codec
+		codec, _ := tunnel.GetCodec(resp.Compression)
+
+		serve := serveTunnel
+		if request.Protocol == "UDP" {
+			serve = serveDatagramTunnel
+		}
+
+		if request.Multiplex {
+			server := tunnel.NewServerSession(&stream{ctx: ctx, send: a, recv: b}, opts, hostname)
+			client := tunnel.NewClientSession(&stream{ctx: ctx, send: b, recv: a}, opts, hostname)
+			server.SetCodec(codec)
+			client.SetCodec(codec)
+
+			go func() {
+				for {
+					conn, err := client.Accept()
+					if err != nil {
+						return
+					}
+					go serve(conn, addr)
+				}
+			}()
+
+			if err := u.Pool().PutSession(resp.TraceId, server); err != nil {
+				_ = server.Close()
+			}
+			return nil
+		}
+
+		server := tunnel.NewConn(&stream{ctx: ctx, send: a, recv: b}, opts, hostname)
+		client := tunnel.NewConn(&stream{ctx: ctx, send: b, recv: a}, opts, hostname)
+		server.SetCodec(codec)
+		client.SetCodec(codec)
+
+		go serve(client, addr)
+
+		go func() {
+			if err := u.Pool().Put(resp.TraceId, server); err != nil {
+				_ = server.Close()
+				return
+			}
+			<-server.Done()
+			u.Pool().Remove(server)
+		}()
+		return nil
+	}
+
+	go func() {
+		_ = upstreams.Watch(ctx, "admin", request, send)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, err := upstreams.Get(hostname); err == nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s was not registered", hostname)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHTTPProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = io.WriteString(w, r.Host+" "+r.Method+" "+r.URL.Path+" "+string(body))
+	}))
+	defer local.Close()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+	watch(ctx, t, upstreams, "www.example.com", "HTTP", local.Listener.Addr().String())
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequest(http.MethodPost, frontend.URL+"/hello", strings.NewReader("world"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "WWW.example.com"
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK || string(body) != "WWW.example.com POST /hello world" {
+			t.Fatalf("unexpected response %d %q", resp.StatusCode, body)
+		}
+	}
+
+	u, err := upstreams.Get("www.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the tunnel kept by the transport between the requests is not in use
+	if stats := u.Pool().Stats(); stats.Active != 0 {
+		t.Fatalf("expected no active tunnel once the responses were read, got %+v", stats)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "unknown.example.com"
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound || !strings.Contains(string(body), "unknown.example.com") {
+		t.Fatalf("expected the not found page, got %d %q", resp.StatusCode, body)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Fatalf("unexpected content type %s", ct)
+	}
+}
+
+func TestHTTPProxy_Multiplex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.URL.Path)
+	}))
+	defer local.Close()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	request := &v1.WatchTunnelsRequest{Hostname: "mux.example.com", Protocol: "HTTP", Multiplex: true}
+	watchRequest(ctx, t, upstreams, request, local.Listener.Addr().String())
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	// every connection of the burst is a stream of the same session
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			req, err := http.NewRequest(http.MethodGet, frontend.URL+"/"+strconv.Itoa(i), nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			req.Host = "mux.example.com"
+			req.Close = true
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK || string(body) != "/"+strconv.Itoa(i) {
+				t.Errorf("unexpected response %d %q", resp.StatusCode, body)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	u, err := upstreams.Get("mux.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d := u.Pool().Deficit(); d != 0 {
+		t.Fatalf("expected the session kept, got deficit %d", d)
+	}
+}
+
+func TestHTTPProxy_Compression(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		_, _ = w.Write(body)
+	}))
+	defer local.Close()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	request := &v1.WatchTunnelsRequest{Hostname: "zstd.example.com", Protocol: "HTTP", Compression: []string{"zstd"}}
+	watchRequest(ctx, t, upstreams, request, local.Listener.Addr().String())
+
+	u, err := upstreams.Get("zstd.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Compression != "zstd" {
+		t.Fatalf("expected zstd negotiated, got %q", u.Compression)
+	}
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	random := make([]byte, 256*1024)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	bodies := map[string][]byte{
+		"application/json": bytes.Repeat([]byte(`{"hello":"world"},`), 16*1024),
+		"image/png":        random,
+	}
+
+	for contentType, body := range bodies {
+		req, err := http.NewRequest(http.MethodPost, frontend.URL, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "zstd.example.com"
+		req.Header.Set("Content-Type", contentType)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(data, body) {
+			t.Fatalf("unexpected %s body of %d bytes", contentType, len(data))
+		}
+	}
+}
+
+func TestHTTPProxy_LoadBalancing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	for _, name := range []string{"a", "b"} {
+		name := name
+
+		local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, name)
+		}))
+		defer local.Close()
+
+		request := &v1.WatchTunnelsRequest{Hostname: "lb.example.com", Protocol: "HTTP", PoolSize: 2,
+			LoadBalancing: "ROUND_ROBIN"}
+		watchRequest(ctx, t, upstreams, request, local.Listener.Addr().String())
+	}
+
+	for len(upstreams.List("lb.example.com")) != 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	// the requests take turns although the tunnels are kept alive between them
+	served := make(map[string]int)
+	for i := 0; i < 6; i++ {
+		req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "lb.example.com"
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected response %d %q", resp.StatusCode, body)
+		}
+		served[string(body)]++
+	}
+
+	if served["a"] != 3 || served["b"] != 3 {
+		t.Fatalf("expected the requests split evenly, got %v", served)
+	}
+}
+
+func TestHTTPProxy_Maintenance(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer local.Close()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+	watch(ctx, t, upstreams, "maintenance.example.com", "HTTP", local.Listener.Addr().String())
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	get := func() (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "maintenance.example.com"
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		body, _ := io.ReadAll(resp.Body)
+		return resp, string(body)
+	}
+
+	id := upstreams.List("maintenance.example.com")[0].ID
+	if _, _, err := upstreams.SetHealthy("admin", "maintenance.example.com", id, false); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, body := get()
+	if resp.StatusCode != http.StatusServiceUnavailable || resp.Header.Get("Retry-After") == "" {
+		t.Fatalf("expected the maintenance page, got %d %v", resp.StatusCode, resp.Header)
+	}
+
+	if !strings.Contains(body, "maintenance") {
+		t.Fatalf("unexpected maintenance page %q", body)
+	}
+
+	if _, _, err := upstreams.SetHealthy("admin", "maintenance.example.com", id, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp, body := get(); resp.StatusCode != http.StatusOK || body != "ok" {
+		t.Fatalf("unexpected response %d %q", resp.StatusCode, body)
+	}
+}
+
+func TestHTTPProxy_PathRouting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	for _, request := range []*v1.WatchTunnelsRequest{
+		{Hostname: "api.example.com", Protocol: "HTTP", PoolSize: 1, PathPrefix: "/v1", StripPrefix: true},
+		{Hostname: "api.example.com", Protocol: "HTTP", PoolSize: 1, PathPrefix: "/v2", RewritePrefix: "/api"},
+		{Hostname: "api.example.com", Protocol: "HTTP", PoolSize: 1, PathPrefix: "/v2/legacy"},
+		{Hostname: "only.example.com", Protocol: "HTTP", PoolSize: 1, PathPrefix: "/v1"},
+	} {
+		name := request.PathPrefix
+
+		local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, name+" "+r.URL.RequestURI()+" "+r.Header.Get("X-Forwarded-Prefix"))
+		}))
+		defer local.Close()
+
+		watchRequest(ctx, t, upstreams, request, local.Listener.Addr().String())
+	}
+
+	for len(upstreams.List("api.example.com")) != 3 {
+		time.Sleep(time.Millisecond)
+	}
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	for _, tc := range []struct {
+		host, path string
+		code       int
+		body       string
+	}{
+		{"api.example.com", "/v1", http.StatusOK, "/v1 / /v1"},
+		{"api.example.com", "/v1/users?id=1", http.StatusOK, "/v1 /users?id=1 /v1"},
+		{"api.example.com", "/v1/a%2Fb", http.StatusOK, "/v1 /a%2Fb /v1"},
+		{"api.example.com", "/v2/users", http.StatusOK, "/v2 /api/users /v2"},
+		{"api.example.com", "/v2/legacy/users", http.StatusOK, "/v2/legacy /v2/legacy/users "},
+		{"api.example.com", "/v10", http.StatusNotFound, ""},
+		{"only.example.com", "/v1/a%2Fb", http.StatusOK, "/v1 /v1/a%2Fb "},
+		{"only.example.com", "/", http.StatusNotFound, ""},
+	} {
+		req, err := http.NewRequest(http.MethodGet, frontend.URL+tc.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = tc.host
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		if resp.StatusCode != tc.code || (tc.code == http.StatusOK && string(body) != tc.body) {
+			t.Fatalf("unexpected response of %s%s: %d %q", tc.host, tc.path, resp.StatusCode, body)
+		}
+	}
+}
+
+func TestHTTPProxy_Wildcard(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	for _, hostname := range []string{"*.wild.example.com", "a.wild.example.com"} {
+		hostname := hostname
+
+		local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, hostname+" "+r.Host)
+		}))
+		defer local.Close()
+
+		watch(ctx, t, upstreams, hostname, "HTTP", local.Listener.Addr().String())
+	}
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	for host, expected := range map[string]string{
+		"a.wild.example.com":   "a.wild.example.com a.wild.example.com",
+		"b.wild.example.com":   "*.wild.example.com b.wild.example.com",
+		"c.wild.example.com":   "*.wild.example.com c.wild.example.com",
+		"x.b.wild.example.com": "",
+	} {
+		req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = host
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		if expected == "" {
+			if resp.StatusCode != http.StatusNotFound {
+				t.Fatalf("expected %s not found, got %d", host, resp.StatusCode)
+			}
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK || string(body) != expected {
+			t.Fatalf("unexpected response of %s: %d %q", host, resp.StatusCode, body)
+		}
+	}
+}
+
+func TestHTTPProxy_Headers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, name := range []string{"X-Forwarded-For", "X-Forwarded-Host", "X-Forwarded-Proto", "Forwarded",
+			"X-Tenant", "X-Internal", "Referer"} {
+			w.Header().Set("Echo-"+name, strings.Join(r.Header.Values(name), ", "))
+		}
+		w.Header().Set("Echo-Host", r.Host)
+		w.Header().Set("Server", "local")
+		w.Header().Set("Location", "http://localhost:8080/login")
+	}))
+	defer local.Close()
+
+	request := &v1.WatchTunnelsRequest{
+		Hostname: "app.example.com",
+		Protocol: "HTTP",
+		PoolSize: 1,
+		RequestHeaders: []*v1.HeaderRule{
+			{Action: "SET", Name: "host", Value: "localhost:8080"},
+			{Action: "ADD", Name: "X-Tenant", Value: "acme"},
+			{Action: "REMOVE", Name: "X-Internal"},
+			{Action: "REPLACE", Name: "Referer", Pattern: `^https?://app\.example\.com`, Value: "http://localhost:8080"},
+		},
+		ResponseHeaders: []*v1.HeaderRule{
+			{Action: "REMOVE", Name: "Server"},
+			{Action: "REPLACE", Name: "Location", Pattern: `^http://localhost:8080`, Value: "https://app.example.com"},
+			{Action: "SET", Name: "X-Frame-Options", Value: "DENY"},
+		},
+	}
+	watchRequest(ctx, t, upstreams, request, local.Listener.Addr().String())
+
+	send := func(p *proxy.HTTPProxy) *http.Response {
+		frontend := httptest.NewServer(p)
+		defer frontend.Close()
+
+		req, err := http.NewRequest(http.MethodGet, frontend.URL+"/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "app.example.com"
+		req.Header.Set("X-Forwarded-For", "203.0.113.7")
+		req.Header.Set("Forwarded", "for=203.0.113.7")
+		req.Header.Set("X-Internal", "secret")
+		req.Header.Set("Referer", "https://app.example.com/home")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+		return resp
+	}
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	resp := send(p)
+
+	// the forwarding headers of an untrusted client are dropped
+	for name, expected := range map[string]string{
+		"Echo-X-Forwarded-For":   "127.0.0.1",
+		"Echo-X-Forwarded-Host":  "app.example.com",
+		"Echo-X-Forwarded-Proto": "http",
+		"Echo-Forwarded":         "for=127.0.0.1;host=app.example.com;proto=http",
+		"Echo-Host":              "localhost:8080",
+		"Echo-X-Tenant":          "acme",
+		"Echo-X-Internal":        "",
+		"Echo-Referer":           "http://localhost:8080/home",
+		"Server":                 "",
+		"Location":               "https://app.example.com/login",
+		"X-Frame-Options":        "DENY",
+	} {
+		if actual := resp.Header.Get(name); actual != expected {
+			t.Fatalf("expected %s %q, got %q", name, expected, actual)
+		}
+	}
+
+	trustedProxies, err := service.ParseCIDRs([]string{"127.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trusted := proxy.NewHTTPProxy(upstreams, nil, nil, trustedProxies)
+	defer trusted.Close()
+
+	resp = send(trusted)
+
+	// those of a trusted proxy are appended to
+	for name, expected := range map[string]string{
+		"Echo-X-Forwarded-For": "203.0.113.7, 127.0.0.1",
+		"Echo-Forwarded":       "for=203.0.113.7, for=127.0.0.1;host=app.example.com;proto=http",
+	} {
+		if actual := resp.Header.Get(name); actual != expected {
+			t.Fatalf("expected %s %q, got %q", name, expected, actual)
+		}
+	}
+
+	for _, rules := range [][]*v1.HeaderRule{
+		{{Action: "REMOVE", Name: "Host"}},
+		{{Action: "REPLACE", Name: "X-Tenant", Pattern: "("}},
+	} {
+		request := &v1.WatchTunnelsRequest{Hostname: "bad.example.com", Protocol: "HTTP", RequestHeaders: rules}
+		err := upstreams.Watch(ctx, "admin", request, func(*v1.WatchTunnelsResponse) error { return nil })
+		if !errors.Is(err, service.ErrInvalidHeaderRule) {
+			t.Fatalf("expected invalid header rule for %v, got %v", rules, err)
+		}
+	}
+}
+
+func TestHTTPProxy_RateLimit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer local.Close()
+
+	watch(ctx, t, upstreams, "limited.example.com", "HTTP", local.Listener.Addr().String())
+
+	limits := service.NewLimitService(&config.LimitOptions{HostnameRequestsPerSecond: 0.5, HostnameRequestBurst: 2})
+
+	p := proxy.NewHTTPProxy(upstreams, limits, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	get := func() *http.Response {
+		req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "limited.example.com"
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return resp
+	}
+
+	for i := 0; i < 2; i++ {
+		if resp := get(); resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected request %d allowed, got %d", i, resp.StatusCode)
+		}
+	}
+
+	resp := get()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected too many requests, got %d", resp.StatusCode)
+	}
+
+	if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err != nil || retryAfter < 1 || retryAfter > 2 {
+		t.Fatalf("unexpected Retry-After %q", resp.Header.Get("Retry-After"))
+	}
+}
+
+func TestHTTPProxy_AccessPolicy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer local.Close()
+
+	watchRequest(ctx, t, upstreams, &v1.WatchTunnelsRequest{Hostname: "staging.example.com", Protocol: "HTTP",
+		PoolSize: 1, AllowCidrs: []string{"203.0.113.0/24"}}, local.Listener.Addr().String())
+
+	get := func(p *proxy.HTTPProxy, forwardedFor string) int {
+		frontend := httptest.NewServer(p)
+		defer frontend.Close()
+
+		req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "staging.example.com"
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// the header of an untrusted client is ignored
+	untrusted := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer untrusted.Close()
+
+	if code := get(untrusted, "203.0.113.10"); code != http.StatusForbidden {
+		t.Fatalf("expected forbidden, got %d", code)
+	}
+
+	trustedProxies, err := service.ParseCIDRs([]string{"127.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trusted := proxy.NewHTTPProxy(upstreams, nil, nil, trustedProxies)
+	defer trusted.Close()
+
+	if code := get(trusted, "203.0.113.10"); code != http.StatusOK {
+		t.Fatalf("expected ok, got %d", code)
+	}
+
+	if code := get(trusted, "198.51.100.1"); code != http.StatusForbidden {
+		t.Fatalf("expected forbidden, got %d", code)
+	}
+}
+
+func TestHTTPProxy_Gate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "authorization="+r.Header.Get("Authorization"))
+	}))
+	defer local.Close()
+
+	watchRequest(ctx, t, upstreams, &v1.WatchTunnelsRequest{Hostname: "preview.example.com", Protocol: "HTTP",
+		PoolSize: 1, BasicAuth: []string{"alice:s3cret"}}, local.Listener.Addr().String())
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	get := func(username, password string) (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "preview.example.com"
+		if username != "" {
+			req.SetBasicAuth(username, password)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp, string(body)
+	}
+
+	resp, _ := get("", "")
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized, got %d", resp.StatusCode)
+	}
+
+	if challenge := resp.Header.Get("WWW-Authenticate"); !strings.HasPrefix(challenge, `Basic realm="preview.example.com"`) {
+		t.Fatalf("unexpected challenge %q", challenge)
+	}
+
+	if resp, _ := get("alice", "guess"); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized, got %d", resp.StatusCode)
+	}
+
+	resp, body := get("alice", "s3cret")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected ok, got %d", resp.StatusCode)
+	}
+
+	if body != "authorization=" {
+		t.Fatalf("expected the credentials kept from the local service, got %q", body)
+	}
+}
+
+func TestHTTPProxy_NotHTTP(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), service.NewPortAllocator("127.0.0.1", 1, 65535),
+		service.NewPortAllocator("127.0.0.1", 1, 65535), 0, "")
+
+	watch(ctx, t, upstreams, "db.example.com", "TCP", "127.0.0.1:9")
+	watch(ctx, t, upstreams, "dns.example.com", "UDP", "127.0.0.1:9")
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	// the tunnels of TCP and UDP upstreams carry no http
+	for _, hostname := range []string{"db.example.com", "dns.example.com"} {
+		req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = hostname
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected not found for %s, got %d", hostname, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"html/template"
+	"net/http"
+	"strconv"
+)
+
+var errorPage = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Code}} {{.Status}}</title>
+</head>
+<body>
+<h1>{{.Code}} {{.Status}}</h1>
+<p>{{.Message}}</p>
+<hr>
+<address>kun</address>
+</body>
+</html>
+`))
+
+// WriteErrorPage replies the request with an html page describing the error
+func WriteErrorPage(w http.ResponseWriter, code int, message string) {
+	h := w.Header()
+	h.Del("Content-Length")
+	h.Set("Content-Type", "text/html; charset=utf-8")
+	h.Set("Cache-Control", "no-store")
+	h.Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+
+	_ = errorPage.Execute(w, struct {
+		Code    string
+		Status  string
+		Message string
+	}{strconv.Itoa(code), http.StatusText(code), message})
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"net"
+	"time"
+)
+
+// acquireTimeout the maximum duration to wait for an idle tunnel
+const acquireTimeout = time.Second * 30
+
+// opener is implemented by the tunnels which must be opened before use
+type opener interface {
+	Open() error
+}
+
+// compressor is implemented by the tunnels compressing their payload
+type compressor interface {
+	SetCompress(compress bool)
+}
+
+// acquire takes an opened tunnel out of the pool of the upstream of hostname
+// chosen by its load balancing policy
+func acquire(ctx context.Context, upstreams *service.UpstreamService, hostname string) (net.Conn, error) {
+	u, err := upstreams.Pick(hostname, "")
+	if err != nil {
+		return nil, err
+	}
+	return acquireFrom(ctx, u)
+}
+
+// acquireFrom takes an opened tunnel out of the pool of u
+func acquireFrom(ctx context.Context, u *service.Upstream) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, acquireTimeout)
+	defer cancel()
+
+	conn, err := u.Pool().Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if o, ok := conn.(opener); ok {
+		if err := o.Open(); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
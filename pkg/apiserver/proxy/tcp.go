@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"net"
+	"time"
+)
+
+// TCPProxy forwards the connections to the public port of every TCP
+// upstream through its tunnels, from its registration until it is removed
+type TCPProxy struct {
+	ctx       context.Context
+	upstreams *service.UpstreamService
+}
+
+// Serve forwards the connections accepted by the listener of u until it is closed
+func (p *TCPProxy) Serve(u *service.Upstream) {
+	l := log.FromContext(p.ctx).Sugar()
+
+	for {
+		conn, err := u.Listener().Accept()
+		if err != nil {
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				time.Sleep(time.Millisecond * 5)
+				continue
+			}
+
+			if !errors.Is(err, net.ErrClosed) {
+				l.Errorf("stop serving upstream %s of %s, got: %v", u.ID, u.DomainName, err)
+			}
+			return
+		}
+
+		go p.handle(u, conn)
+	}
+}
+
+// handle forwards conn through a tunnel of the hostname of u, unless its
+// client is refused by the access policy of u
+func (p *TCPProxy) handle(u *service.Upstream, conn net.Conn) {
+	if !u.Allowed(service.ClientIP(conn.RemoteAddr().String(), nil, nil)) {
+		log.FromContext(p.ctx).Sugar().Debugf("refuse tcp connection from %s to %s", conn.RemoteAddr(), u.DomainName)
+		_ = conn.Close()
+		return
+	}
+
+	tunnelConn, err := acquire(p.ctx, p.upstreams, u.DomainName)
+	if err != nil {
+		log.FromContext(p.ctx).Sugar().Warnf("unable forward tcp connection from %s to %s, got: %v",
+			conn.RemoteAddr(), u.DomainName, err)
+		_ = conn.Close()
+		return
+	}
+	tunnel.Pipe(conn, tunnelConn)
+}
+
+// onRegister serves the TCP upstreams once they are registered
+func (p *TCPProxy) onRegister(u *service.Upstream) {
+	if u.Protocol == model.ProtocolTCP && u.Listener() != nil {
+		p.Serve(u)
+	}
+}
+
+// NewTCPProxy create TCPProxy serving the TCP upstreams of upstreams
+func NewTCPProxy(ctx context.Context, upstreams *service.UpstreamService) *TCPProxy {
+	p := &TCPProxy{ctx: ctx, upstreams: upstreams}
+	upstreams.OnRegister(p.onRegister)
+	return p
+}
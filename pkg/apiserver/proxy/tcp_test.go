@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy_test
+
+import (
+	"context"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+)
+
+func TestTCPProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the local echo service
+	local, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer local.Close()
+
+	go func() {
+		for {
+			conn, err := local.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), service.NewPortAllocator("127.0.0.1", 1, 65535), nil, 0, "")
+	proxy.NewTCPProxy(ctx, upstreams)
+
+	watch(ctx, t, upstreams, "ssh.example.com", "TCP", local.Addr().String())
+
+	u, err := upstreams.Get("ssh.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Port == 0 {
+		t.Fatal("expected a public port allocated")
+	}
+
+	for i := 0; i < 2; i++ {
+		conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(u.Port))))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := conn.Write([]byte("ping")); err != nil {
+			t.Fatal(err)
+		}
+		_ = conn.(*net.TCPConn).CloseWrite()
+
+		data, err := io.ReadAll(conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = conn.Close()
+
+		if string(data) != "ping" {
+			t.Fatalf("unexpected data %q", data)
+		}
+	}
+}
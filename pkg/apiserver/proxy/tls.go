@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// errClientHelloRead aborts the handshake once the client hello is read
+var errClientHelloRead = errors.New("client hello read")
+
+// TLSProxy forwards the tls connections of the TLS upstreams through their
+// tunnels without terminating them, the upstream is chosen by the server
+// name of the client hello
+type TLSProxy struct {
+	upstreams        *service.UpstreamService
+	handshakeTimeout time.Duration
+}
+
+// Listen wraps ln so that the connections to the TLS upstreams are passed
+// through, the other connections are returned by Accept of the returned
+// listener with the bytes read from them replayed
+func (p *TLSProxy) Listen(ctx context.Context, ln net.Listener) net.Listener {
+	l := &passthroughListener{
+		Listener: ln,
+		conns:    make(chan net.Conn),
+		done:     make(chan struct{}),
+	}
+
+	go l.acceptLoop(ctx, p)
+	return l
+}
+
+// route passes conn through if its server name belongs to a TLS upstream
+// whose access policy allows its client, or returns the conn to be served by
+// the frontend
+func (p *TLSProxy) route(ctx context.Context, conn net.Conn) (net.Conn, bool) {
+	l := log.FromContext(ctx).Sugar()
+
+	_ = conn.SetReadDeadline(time.Now().Add(p.handshakeTimeout))
+	serverName, hello, err := readClientHello(conn)
+	_ = conn.SetReadDeadline(time.Time{})
+
+	replayed := &replayConn{Conn: conn, r: io.MultiReader(bytes.NewReader(hello), conn)}
+	if err != nil || serverName == "" {
+		return replayed, false
+	}
+
+	u, err := p.upstreams.Get(serverName)
+	if err != nil || u.Protocol != model.ProtocolTLS {
+		return replayed, false
+	}
+
+	if !u.Allowed(service.ClientIP(conn.RemoteAddr().String(), nil, nil)) {
+		l.Debugf("refuse tls connection from %s to %s", conn.RemoteAddr(), serverName)
+		_ = conn.Close()
+		return nil, true
+	}
+
+	tunnelConn, err := acquire(ctx, p.upstreams, serverName)
+	if err != nil {
+		l.Warnf("unable pass through tls connection of %s, got: %v", serverName, err)
+		_ = conn.Close()
+		return nil, true
+	}
+
+	if _, err := tunnelConn.Write(hello); err != nil {
+		_ = tunnelConn.Close()
+		_ = conn.Close()
+		return nil, true
+	}
+
+	go tunnel.Pipe(conn, tunnelConn)
+	return nil, true
+}
+
+// NewTLSProxy create TLSProxy forwarding the tls connections to upstreams,
+// a client must send its client hello within handshakeTimeout
+func NewTLSProxy(upstreams *service.UpstreamService, handshakeTimeout time.Duration) *TLSProxy {
+	return &TLSProxy{upstreams: upstreams, handshakeTimeout: handshakeTimeout}
+}
+
+// passthroughListener returns the connections which are not passed through
+type passthroughListener struct {
+	net.Listener
+
+	conns     chan net.Conn
+	err       error
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Accept implements net.Listener
+func (l *passthroughListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		if l.err != nil {
+			return nil, l.err
+		}
+		return nil, net.ErrClosed
+	}
+}
+
+// Close implements net.Listener
+func (l *passthroughListener) Close() error {
+	err := l.Listener.Close()
+	l.closeOnce.Do(func() {
+		close(l.done)
+	})
+	return err
+}
+
+// acceptLoop routes the accepted connections until the listener is closed
+func (l *passthroughListener) acceptLoop(ctx context.Context, p *TLSProxy) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				time.Sleep(time.Millisecond * 5)
+				continue
+			}
+
+			l.closeOnce.Do(func() {
+				l.err = err
+				close(l.done)
+			})
+			return
+		}
+
+		go func() {
+			served, passed := p.route(ctx, conn)
+			if passed {
+				return
+			}
+
+			select {
+			case l.conns <- served:
+			case <-l.done:
+				_ = served.Close()
+			}
+		}()
+	}
+}
+
+// readClientHello reads the client hello from conn, it returns the server
+// name and the bytes read
+func readClientHello(conn net.Conn) (string, []byte, error) {
+	var (
+		buf        bytes.Buffer
+		serverName string
+		read       bool
+	)
+
+	err := tls.Server(readOnlyConn{Conn: conn, r: io.TeeReader(conn, &buf)}, &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			serverName, read = hello.ServerName, true
+			return nil, errClientHelloRead
+		},
+	}).Handshake()
+
+	if !read {
+		return "", buf.Bytes(), err
+	}
+	return service.NormalizeHostname(serverName), buf.Bytes(), nil
+}
+
+// readOnlyConn lets tls.Server read the client hello without answering it
+type readOnlyConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c readOnlyConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+func (c readOnlyConn) Write([]byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func (c readOnlyConn) Close() error {
+	return nil
+}
+
+func (c readOnlyConn) SetDeadline(time.Time) error {
+	return nil
+}
+
+func (c readOnlyConn) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+func (c readOnlyConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+// replayConn replays the bytes read from Conn before
+type replayConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *replayConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
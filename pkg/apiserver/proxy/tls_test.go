@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy_test
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTLSProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the local service terminating tls itself
+	local := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "local")
+	}))
+	defer local.Close()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+	watch(ctx, t, upstreams, "secure.example.com", "TLS", local.Listener.Addr().String())
+	watch(ctx, t, upstreams, "www.example.com", "HTTP", local.Listener.Addr().String())
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the https frontend terminating the other connections
+	frontend := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "frontend")
+	}))
+	frontend.Listener = proxy.NewTLSProxy(upstreams, time.Second).Listen(ctx, ln)
+	frontend.StartTLS()
+	defer frontend.Close()
+
+	tests := map[string]struct {
+		body        string
+		certificate *httptest.Server
+	}{
+		"secure.example.com": {"local", local},
+		"www.example.com":    {"frontend", frontend},
+	}
+
+	for serverName, expected := range tests {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{ServerName: serverName, InsecureSkipVerify: true},
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, ln.Addr().String())
+			},
+		}}
+
+		resp, err := client.Get("https://" + serverName + "/")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		if string(body) != expected.body {
+			t.Errorf("expected %s served by %s, got %q", serverName, expected.body, body)
+		}
+
+		if !resp.TLS.PeerCertificates[0].Equal(expected.certificate.Certificate()) {
+			t.Errorf("expected the tls connection of %s terminated by %s", serverName, expected.body)
+		}
+	}
+}
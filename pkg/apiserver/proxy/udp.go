@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// udpQueueSize the number of datagrams of a source address buffered while
+// its tunnel is acquired, the datagrams beyond are dropped
+const udpQueueSize = 64
+
+// UDPProxy forwards the datagrams to the public port of every UDP upstream
+// through its tunnels, from its registration until it is removed. Every
+// source address gets a tunnel of its own, which is closed once no datagram
+// was exchanged for the session timeout.
+type UDPProxy struct {
+	ctx            context.Context
+	upstreams      *service.UpstreamService
+	sessionTimeout time.Duration
+}
+
+// udpSession the datagrams exchanged with a source address
+type udpSession struct {
+	addr  net.Addr
+	queue chan []byte
+	// lastActive the unix nano time of the last datagram in either direction
+	lastActive int64
+}
+
+// touch marks the session active
+func (s *udpSession) touch() {
+	atomic.StoreInt64(&s.lastActive, time.Now().UnixNano())
+}
+
+// Serve forwards the datagrams received by the packet conn of u until it is
+// closed, those of the sources refused by the access policy of u are dropped
+func (p *UDPProxy) Serve(u *service.Upstream) {
+	l := log.FromContext(p.ctx).Sugar()
+
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	sessions := make(map[string]*udpSession)
+
+	pc := u.PacketConn()
+	buf := make([]byte, tunnel.MaxDatagramSize)
+
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				time.Sleep(time.Millisecond * 5)
+				continue
+			}
+
+			if !errors.Is(err, net.ErrClosed) {
+				l.Errorf("stop serving upstream %s of %s, got: %v", u.ID, u.DomainName, err)
+			}
+			return
+		}
+
+		key := addr.String()
+
+		mu.Lock()
+		s, ok := sessions[key]
+		if !ok {
+			if !u.Allowed(service.ClientIP(key, nil, nil)) {
+				mu.Unlock()
+				l.Debugf("drop datagram from %s to %s, refused by its access policy", addr, u.DomainName)
+				continue
+			}
+
+			s = &udpSession{addr: addr, queue: make(chan []byte, udpQueueSize)}
+			s.touch()
+			sessions[key] = s
+
+			go func() {
+				p.handle(ctx, u, s)
+
+				mu.Lock()
+				delete(sessions, key)
+				mu.Unlock()
+			}()
+		}
+		mu.Unlock()
+
+		datagram := make([]byte, n)
+		copy(datagram, buf[:n])
+
+		select {
+		case s.queue <- datagram:
+		default:
+			l.Debugf("drop datagram from %s to %s, the queue is full", addr, u.DomainName)
+		}
+	}
+}
+
+// handle forwards the datagrams of s through a tunnel of the hostname of u
+// and writes the replies back to its source address, until s is idle for
+// too long
+func (p *UDPProxy) handle(ctx context.Context, u *service.Upstream, s *udpSession) {
+	l := log.FromContext(ctx).Sugar()
+
+	conn, err := acquire(ctx, p.upstreams, u.DomainName)
+	if err != nil {
+		l.Warnf("unable forward udp datagrams from %s to %s, got: %v", s.addr, u.DomainName, err)
+		return
+	}
+
+	dc := tunnel.NewDatagramConn(conn)
+	defer dc.Close()
+
+	replied := make(chan struct{})
+	go func() {
+		defer close(replied)
+
+		buf := make([]byte, tunnel.MaxDatagramSize)
+		for {
+			n, err := dc.ReadDatagram(buf)
+			if err != nil {
+				return
+			}
+
+			s.touch()
+
+			if _, err := u.PacketConn().WriteTo(buf[:n], s.addr); err != nil {
+				return
+			}
+		}
+	}()
+
+	timer := time.NewTimer(p.sessionTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-replied:
+			return
+		case datagram := <-s.queue:
+			s.touch()
+
+			if err := dc.WriteDatagram(datagram); err != nil {
+				return
+			}
+		case <-timer.C:
+			idle := time.Since(time.Unix(0, atomic.LoadInt64(&s.lastActive)))
+			if idle >= p.sessionTimeout {
+				l.Debugf("udp session from %s to %s expired", s.addr, u.DomainName)
+				return
+			}
+			timer.Reset(p.sessionTimeout - idle)
+		}
+	}
+}
+
+// onRegister serves the UDP upstreams once they are registered
+func (p *UDPProxy) onRegister(u *service.Upstream) {
+	if u.Protocol == model.ProtocolUDP && u.PacketConn() != nil {
+		p.Serve(u)
+	}
+}
+
+// NewUDPProxy create UDPProxy serving the UDP upstreams of upstreams, closing
+// the tunnel of a source address idle for sessionTimeout
+func NewUDPProxy(ctx context.Context, upstreams *service.UpstreamService, sessionTimeout time.Duration) *UDPProxy {
+	p := &UDPProxy{ctx: ctx, upstreams: upstreams, sessionTimeout: sessionTimeout}
+	upstreams.OnRegister(p.onRegister)
+	return p
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy_test
+
+import (
+	"context"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// serveDatagramTunnel emulates the client relaying the datagrams of a UDP
+// tunnel to the local service at addr
+func serveDatagramTunnel(conn *tunnel.Conn, addr string) {
+	defer conn.Close()
+
+	select {
+	case <-conn.Opened():
+	case <-conn.Done():
+		return
+	}
+
+	local, err := net.Dial("udp", addr)
+	if err != nil {
+		return
+	}
+	defer local.Close()
+
+	dc := tunnel.NewDatagramConn(conn)
+
+	go func() {
+		buf := make([]byte, tunnel.MaxDatagramSize)
+		for {
+			n, err := local.Read(buf)
+			if err != nil {
+				return
+			}
+			if err := dc.WriteDatagram(buf[:n]); err != nil {
+				return
+			}
+		}
+	}()
+
+	buf := make([]byte, tunnel.MaxDatagramSize)
+	for {
+		n, err := dc.ReadDatagram(buf)
+		if err != nil {
+			return
+		}
+		if _, err := local.Write(buf[:n]); err != nil {
+			return
+		}
+	}
+}
+
+func TestUDPProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the local echo service, recording the addresses of the tunnels
+	local, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer local.Close()
+
+	var mu sync.Mutex
+	sources := make(map[string]struct{})
+
+	go func() {
+		buf := make([]byte, tunnel.MaxDatagramSize)
+		for {
+			n, addr, err := local.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			sources[addr.String()] = struct{}{}
+			mu.Unlock()
+
+			_, _ = local.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, service.NewPortAllocator("127.0.0.1", 1, 65535), 0, "")
+	proxy.NewUDPProxy(ctx, upstreams, time.Millisecond*200)
+
+	watch(ctx, t, upstreams, "dns.example.com", "UDP", local.LocalAddr().String())
+
+	u, err := upstreams.Get("dns.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Port == 0 {
+		t.Fatal("expected a public port allocated")
+	}
+
+	public := net.JoinHostPort("127.0.0.1", strconv.Itoa(int(u.Port)))
+
+	exchange := func(conn net.Conn, datagram string) {
+		if _, err := conn.Write([]byte(datagram)); err != nil {
+			t.Fatal(err)
+		}
+
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+
+		buf := make([]byte, tunnel.MaxDatagramSize)
+		n, err := conn.Read(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(buf[:n]) != datagram {
+			t.Fatalf("unexpected datagram %q, expected %q", buf[:n], datagram)
+		}
+	}
+
+	a, err := net.Dial("udp", public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+
+	b, err := net.Dial("udp", public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	// the datagrams of a source address share its tunnel
+	exchange(a, "query 1")
+	exchange(a, "query 2")
+	exchange(b, "query 3")
+
+	mu.Lock()
+	if len(sources) != 2 {
+		t.Fatalf("expected a tunnel for every source address, got %d", len(sources))
+	}
+	mu.Unlock()
+
+	// the idle session expires, the next datagram opens another tunnel
+	time.Sleep(time.Millisecond * 500)
+	exchange(a, "query 4")
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(sources) != 3 {
+		t.Fatalf("expected the idle session expired, got %d tunnels", len(sources))
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"golang.org/x/net/http/httpguts"
+	"net/http"
+	"time"
+)
+
+// isUpgrade reports whether r asks to switch its connection to another
+// protocol, e.g. websocket or h2c
+func isUpgrade(r *http.Request) bool {
+	return r.ProtoMajor == 1 && r.Header.Get("Upgrade") != "" &&
+		httpguts.HeaderValuesContainsToken(r.Header["Connection"], "Upgrade")
+}
+
+// serveUpgrade forwards the upgrade request r through a tunnel, then hands
+// the hijacked connection to the tunnel as raw bytes in both directions. The
+// response of the upstream, whether it switches protocols or not, is read by
+// the client from the tunnel as is.
+func (p *HTTPProxy) serveUpgrade(w http.ResponseWriter, r *http.Request) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		p.proxy.ServeHTTP(w, r)
+		return
+	}
+
+	u, err := p.upstreams.Pick(r.Host, r.URL.Path)
+	if err != nil {
+		p.errorHandler(w, r, err)
+		return
+	}
+
+	conn, err := acquireFrom(r.Context(), u)
+	if err != nil {
+		p.errorHandler(w, r, err)
+		return
+	}
+
+	// the hop-by-hop headers negotiating the upgrade are kept
+	out := r.Clone(r.Context())
+	setForwardedHeaders(out, p.trustedProxies)
+	appendForwardedFor(out)
+	rewritePath(out, u)
+	u.RewriteRequest(out)
+	if _, ok := out.Header["User-Agent"]; !ok {
+		// explicitly disable the default User-Agent of Request.Write
+		out.Header.Set("User-Agent", "")
+	}
+
+	if err := out.Write(conn); err != nil {
+		_ = conn.Close()
+		p.errorHandler(w, r, err)
+		return
+	}
+
+	client, rw, err := hj.Hijack()
+	if err != nil {
+		_ = conn.Close()
+		p.errorHandler(w, r, err)
+		return
+	}
+
+	// the deadlines of the http server do not apply to the switched protocol
+	_ = client.SetDeadline(time.Time{})
+
+	// the bytes the client sent after the request may be buffered already
+	if n := rw.Reader.Buffered(); n > 0 {
+		buffered, _ := rw.Reader.Peek(n)
+		if _, err := conn.Write(buffered); err != nil {
+			_ = conn.Close()
+			_ = client.Close()
+			return
+		}
+	}
+
+	tunnel.Pipe(client, conn)
+}
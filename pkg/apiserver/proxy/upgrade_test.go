@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy_test
+
+import (
+	"bufio"
+	"context"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPProxy_Upgrade(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the local service switches to an echo protocol
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "echo" || r.Header.Get("HTTP2-Settings") != "AAMAAABkAAQAAP__" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		conn, rw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, _ = io.WriteString(conn, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: echo\r\n\r\n")
+		_, _ = io.Copy(conn, rw)
+	}))
+	defer local.Close()
+
+	upstreams := service.NewUpstreamService(newTokenService(t), nil, nil, 0, "")
+	watch(ctx, t, upstreams, "ws.example.com", "HTTP", local.Listener.Addr().String())
+
+	p := proxy.NewHTTPProxy(upstreams, nil, nil, nil)
+	defer p.Close()
+
+	frontend := httptest.NewServer(p)
+	defer frontend.Close()
+
+	conn, err := net.Dial("tcp", frontend.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(time.Second * 5))
+
+	// the first bytes of the switched protocol follow the request at once
+	_, err = io.WriteString(conn, "GET /live HTTP/1.1\r\nHost: ws.example.com\r\n"+
+		"Connection: Upgrade, HTTP2-Settings\r\nUpgrade: echo\r\nHTTP2-Settings: AAMAAABkAAQAAP__\r\n\r\nhello ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	br := bufio.NewReader(conn)
+
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Upgrade") != "echo" {
+		t.Fatalf("unexpected response %d %v", resp.StatusCode, resp.Header)
+	}
+
+	if _, err := io.WriteString(conn, "world"); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.(*net.TCPConn).CloseWrite()
+
+	data, err := io.ReadAll(br)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "hello world" {
+		t.Fatalf("unexpected data %q", data)
+	}
+}
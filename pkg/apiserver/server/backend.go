@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/log"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"request":            tls.RequestClientCert,
+	"require":            tls.RequireAnyClientCert,
+	"verify_if_given":    tls.VerifyClientCertIfGiven,
+	"require_and_verify": tls.RequireAndVerifyClientCert,
+}
+
+// BackendServer serves the grpc api and its http gateway for the clients
+type BackendServer struct {
+	srv *http.Server
+	cfg *config.Configuration
+}
+
+// Start the backend http server
+func (s *BackendServer) Start(baseCtx context.Context) error {
+	ctx, cancel := context.WithCancel(baseCtx)
+	defer cancel()
+
+	l := log.FromContext(ctx).Sugar()
+	l.Infof("backend server listen at %s", s.srv.Addr)
+
+	var err error
+	if s.cfg.Backend.CertificateFile != "" {
+		err = s.srv.ListenAndServeTLS(s.cfg.Backend.CertificateFile, s.cfg.Backend.CertificateKeyFile)
+	} else {
+		err = s.srv.ListenAndServe()
+	}
+
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		l.Errorln(err)
+		return err
+	}
+	return nil
+}
+
+// GracefulStop graceful shutdown backend server
+func (s *BackendServer) GracefulStop(ctx context.Context) error {
+	l := log.FromContext(ctx).Sugar()
+
+	l.Infoln("stopping the backend server gracefully..")
+	if err := s.srv.Shutdown(ctx); err != nil {
+		l.Errorln(err)
+		return err
+	}
+	return nil
+}
+
+// NewBackendServer create BackendServer which dispatches grpc requests to
+// grpcServer and the others to gateway
+func NewBackendServer(cfg *config.Configuration, grpcServer *grpc.Server, gateway http.Handler) (*BackendServer, error) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			clearDeadlines(w)
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		gateway.ServeHTTP(w, r)
+	})
+
+	srv := &http.Server{
+		Addr:              cfg.Backend.BindAddr,
+		ReadTimeout:       time.Duration(cfg.Backend.ReadTimeout),
+		ReadHeaderTimeout: time.Duration(cfg.Backend.ReadHeaderTimeout),
+		WriteTimeout:      time.Duration(cfg.Backend.WriteTimeout),
+		IdleTimeout:       time.Duration(cfg.Backend.IdleTimeout),
+		MaxHeaderBytes:    cfg.Backend.MaxHeaderBytes,
+	}
+
+	if cfg.Backend.CertificateFile == "" {
+		// serve grpc over cleartext http/2 with prior knowledge
+		srv.Handler = h2c.NewHandler(handler, &http2.Server{})
+		return &BackendServer{srv: srv, cfg: cfg}, nil
+	}
+
+	tlsConfig, err := newTLSConfig(cfg.Backend.RootCAPool, cfg.Backend.ClientAuthMode)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.Handler = handler
+	srv.TLSConfig = tlsConfig
+	return &BackendServer{srv: srv, cfg: cfg}, nil
+}
+
+// deadlineSetter a response writer whose stream deadlines can be changed,
+// those of http/2 are
+type deadlineSetter interface {
+	SetReadDeadline(deadline time.Time) error
+	SetWriteDeadline(deadline time.Time) error
+}
+
+// clearDeadlines clears the read and write timeouts the http/2 server applies
+// to the stream of w. A grpc stream lives as long as the tunnel it carries,
+// which is probed by the keepalive of the tunnel instead.
+func clearDeadlines(w http.ResponseWriter) {
+	if d, ok := w.(deadlineSetter); ok {
+		_ = d.SetReadDeadline(time.Time{})
+		_ = d.SetWriteDeadline(time.Time{})
+	}
+}
+
+// newTLSConfig create tls.Config that authenticates clients with the
+// certificate authorities in rootCAPool according to clientAuthMode
+func newTLSConfig(rootCAPool []string, clientAuthMode string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if clientAuthMode != "" {
+		tlsConfig.ClientAuth = clientAuthTypes[clientAuthMode]
+	}
+
+	if len(rootCAPool) == 0 {
+		return tlsConfig, nil
+	}
+
+	tlsConfig.ClientCAs = x509.NewCertPool()
+
+	for _, caFile := range rootCAPool {
+		data, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok := tlsConfig.ClientCAs.AppendCertsFromPEM(data); !ok {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server_test
+
+import (
+	"context"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/server"
+	"github.com/aapelismith/kun/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// echoStream the bidirectional stream echoing the tunnel messages it receives
+var echoStream = grpc.StreamDesc{
+	StreamName:    "Echo",
+	ServerStreams: true,
+	ClientStreams: true,
+	Handler: func(_ interface{}, stream grpc.ServerStream) error {
+		for {
+			msg := new(v1.TunnelMessage)
+			if err := stream.RecvMsg(msg); err != nil {
+				return nil
+			}
+
+			if err := stream.SendMsg(msg); err != nil {
+				return err
+			}
+		}
+	},
+}
+
+func TestBackendServer_LongLivedStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	cfg := config.NewConfiguration()
+	cfg.SetDefaults()
+	cfg.Backend.BindAddr = addr
+	cfg.Backend.ReadTimeout = types.Duration(time.Millisecond * 200)
+	cfg.Backend.WriteTimeout = types.Duration(time.Millisecond * 200)
+
+	grpcServer := grpc.NewServer()
+	grpcServer.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Echo",
+		HandlerType: (*interface{})(nil),
+		Streams:     []grpc.StreamDesc{echoStream},
+	}, struct{}{})
+
+	backend, err := server.NewBackendServer(cfg, grpcServer, http.NotFoundHandler())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() { _ = backend.Start(ctx) }()
+	defer func() { _ = backend.GracefulStop(context.Background()) }()
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, time.Second*5)
+	defer dialCancel()
+
+	conn, err := grpc.DialContext(dialCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	stream, err := conn.NewStream(ctx, &echoStream, "/test.Echo/Echo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the stream outlives the read and write timeouts of the server
+	for i := 0; i < 4; i++ {
+		if err := stream.SendMsg(&v1.TunnelMessage{Payload: []byte("ping")}); err != nil {
+			t.Fatal(err)
+		}
+
+		msg := new(v1.TunnelMessage)
+		if err := stream.RecvMsg(msg); err != nil {
+			t.Fatalf("stream closed after %d round trips, got: %v", i, err)
+		}
+		time.Sleep(time.Millisecond * 150)
+	}
+}
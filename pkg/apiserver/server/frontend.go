@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/log"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"net"
+	"net/http"
+	"time"
+)
+
+// FrontendServer serves the public http or https entrypoint of the tunnels
+type FrontendServer struct {
+	srv         *http.Server
+	cfg         *config.Configuration
+	passthrough *proxy.TLSProxy
+}
+
+// Start the frontend http server
+func (s *FrontendServer) Start(baseCtx context.Context) error {
+	ctx, cancel := context.WithCancel(baseCtx)
+	defer cancel()
+
+	l := log.FromContext(ctx).Sugar()
+
+	var err error
+	if s.srv.TLSConfig != nil {
+		l.Infof("frontend https server listen at %s", s.srv.Addr)
+		err = s.serveTLS(ctx)
+	} else {
+		l.Infof("frontend http server listen at %s", s.srv.Addr)
+		err = s.srv.ListenAndServe()
+	}
+
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		l.Errorln(err)
+		return err
+	}
+	return nil
+}
+
+// serveTLS serves https on the frontend listener, the connections of the
+// TLS upstreams are passed through before reaching the http server
+func (s *FrontendServer) serveTLS(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.srv.Addr)
+	if err != nil {
+		return err
+	}
+
+	if s.passthrough != nil {
+		ln = s.passthrough.Listen(ctx, ln)
+	}
+
+	// the certificates are chosen by s.srv.TLSConfig.GetCertificate
+	return s.srv.ServeTLS(ln, "", "")
+}
+
+// GracefulStop graceful shutdown frontend server
+func (s *FrontendServer) GracefulStop(ctx context.Context) error {
+	l := log.FromContext(ctx).Sugar()
+
+	l.Infof("stopping the frontend server at %s gracefully..", s.srv.Addr)
+	if err := s.srv.Shutdown(ctx); err != nil {
+		l.Errorln(err)
+		return err
+	}
+	return nil
+}
+
+// NewFrontendServer create FrontendServer which serves handler at the
+// http bind address of the frontend, HTTP/2 is served to the clients
+// with prior knowledge
+func NewFrontendServer(ctx context.Context, cfg *config.Configuration, handler http.Handler) *FrontendServer {
+	handler = newH2CHandler(handler, newFrontendHTTP2Server(cfg))
+	return &FrontendServer{srv: newFrontendHTTPServer(ctx, cfg, cfg.Frontend.HttpBindAddr, handler), cfg: cfg}
+}
+
+// NewSecureFrontendServer create FrontendServer which serves handler at the
+// https bind address of the frontend, getCertificate selects the certificate
+// by the server name of the client. The connections of the TLS upstreams are
+// forwarded by passthrough instead if it is not nil.
+func NewSecureFrontendServer(ctx context.Context, cfg *config.Configuration, handler http.Handler,
+	getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error), passthrough *proxy.TLSProxy) (*FrontendServer, error) {
+	srv := newFrontendHTTPServer(ctx, cfg, cfg.Frontend.HttpsBindAddr, handler)
+	srv.TLSConfig = &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: getCertificate,
+		NextProtos:     []string{"h2", "http/1.1"},
+	}
+
+	// acme-tls/1 is negotiated by the TLS-ALPN-01 challenges only
+	if cfg.ACME.Enabled {
+		srv.TLSConfig.NextProtos = append(srv.TLSConfig.NextProtos, acme.ALPNProto)
+	}
+
+	if err := http2.ConfigureServer(srv, newFrontendHTTP2Server(cfg)); err != nil {
+		return nil, err
+	}
+	return &FrontendServer{srv: srv, cfg: cfg, passthrough: passthrough}, nil
+}
+
+// newFrontendHTTPServer create http.Server listening at addr with the
+// timeouts of the frontend
+func newFrontendHTTPServer(ctx context.Context, cfg *config.Configuration, addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadTimeout:       time.Duration(cfg.Frontend.ReadTimeout),
+		ReadHeaderTimeout: time.Duration(cfg.Frontend.ReadHeaderTimeout),
+		WriteTimeout:      time.Duration(cfg.Frontend.WriteTimeout),
+		IdleTimeout:       time.Duration(cfg.Frontend.IdleTimeout),
+		MaxHeaderBytes:    cfg.Frontend.MaxHeaderBytes,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+}
+
+// newFrontendHTTP2Server create http2.Server with the HTTP/2 options of the frontend
+func newFrontendHTTP2Server(cfg *config.Configuration) *http2.Server {
+	return &http2.Server{
+		MaxConcurrentStreams: cfg.Frontend.HTTP2MaxConcurrentStreams,
+		IdleTimeout:          time.Duration(cfg.Frontend.IdleTimeout),
+	}
+}
+
+// newH2CHandler serves the HTTP/2 connections with prior knowledge by h2s,
+// the "Upgrade: h2c" requests are passed to handler like any other upgrade
+func newH2CHandler(handler http.Handler, h2s *http2.Server) http.Handler {
+	h2cHandler := h2c.NewHandler(handler, h2s)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PRI" && len(r.Header) == 0 && r.URL.Path == "*" && r.Proto == "HTTP/2.0" {
+			h2cHandler.ServeHTTP(w, r)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
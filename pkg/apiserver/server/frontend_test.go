@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server_test
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/server"
+	"golang.org/x/crypto/acme"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// dialALPN completes a tls handshake with addr offering protocols
+func dialALPN(addr string, protocols ...string) (string, error) {
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", addr, &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         protocols,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	return conn.ConnectionState().NegotiatedProtocol, nil
+}
+
+func TestSecureFrontendServer_ACMEProto(t *testing.T) {
+	// borrow the self signed certificate of httptest
+	local := httptest.NewTLSServer(http.NotFoundHandler())
+	defer local.Close()
+
+	certificate := local.TLS.Certificates[0]
+	getCertificate := func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return &certificate, nil
+	}
+
+	for _, enabled := range []bool{false, true} {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr := ln.Addr().String()
+		_ = ln.Close()
+
+		cfg := config.NewConfiguration()
+		cfg.SetDefaults()
+		cfg.Frontend.HttpsBindAddr = addr
+		cfg.ACME.Enabled = enabled
+
+		frontend, err := server.NewSecureFrontendServer(context.Background(), cfg, http.NotFoundHandler(),
+			getCertificate, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		go func() { _ = frontend.Start(context.Background()) }()
+
+		deadline := time.Now().Add(time.Second * 5)
+		for {
+			if _, err := dialALPN(addr, "http/1.1"); err == nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("frontend at %s not ready", addr)
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+
+		proto, err := dialALPN(addr, acme.ALPNProto)
+		if enabled && (err != nil || proto != acme.ALPNProto) {
+			t.Fatalf("expected %s negotiated with acme enabled, got %q %v", acme.ALPNProto, proto, err)
+		}
+		if !enabled && err == nil {
+			t.Fatalf("expected %s refused with acme disabled, got %q", acme.ALPNProto, proto)
+		}
+
+		_ = frontend.GracefulStop(context.Background())
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// localStream collects the header and trailer a unary handler sets
+type localStream struct {
+	method  string
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+// Method implements grpc.ServerTransportStream
+func (s *localStream) Method() string {
+	return s.method
+}
+
+// SetHeader implements grpc.ServerTransportStream
+func (s *localStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+// SendHeader implements grpc.ServerTransportStream
+func (s *localStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+// SetTrailer implements grpc.ServerTransportStream
+func (s *localStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+// LocalConn calls the unary methods of a service in process through the
+// unary interceptor of the grpc server, so that the http gateway reaches
+// the service authenticated as the grpc clients are
+type LocalConn struct {
+	srv         interface{}
+	methods     map[string]grpc.MethodDesc
+	interceptor grpc.UnaryServerInterceptor
+}
+
+// Invoke implements grpc.ClientConnInterface
+func (c *LocalConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{},
+	opts ...grpc.CallOption) error {
+	desc, ok := c.methods[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	stream := &localStream{method: method}
+	ctx = grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, md.Copy()), stream)
+
+	dec := func(v interface{}) error {
+		proto.Merge(v.(proto.Message), args.(proto.Message))
+		return nil
+	}
+
+	resp, err := desc.Handler(c.srv, ctx, dec, c.interceptor)
+
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case grpc.HeaderCallOption:
+			*o.HeaderAddr = stream.header
+		case grpc.TrailerCallOption:
+			*o.TrailerAddr = stream.trailer
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+	proto.Merge(reply.(proto.Message), resp.(proto.Message))
+	return nil
+}
+
+// NewStream implements grpc.ClientConnInterface, the streaming methods are
+// served over grpc only
+func (c *LocalConn) NewStream(_ context.Context, _ *grpc.StreamDesc, method string,
+	_ ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "streaming method %s is not supported over http", method)
+}
+
+// NewLocalConn create LocalConn calling the unary methods of desc served by
+// srv through interceptor, which may be nil
+func NewLocalConn(desc *grpc.ServiceDesc, srv interface{}, interceptor grpc.UnaryServerInterceptor) *LocalConn {
+	methods := make(map[string]grpc.MethodDesc, len(desc.Methods))
+	for _, m := range desc.Methods {
+		methods[fmt.Sprintf("/%s/%s", desc.ServiceName, m.MethodName)] = m
+	}
+	return &LocalConn{srv: srv, methods: methods, interceptor: interceptor}
+}
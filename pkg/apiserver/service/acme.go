@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"github.com/aapelismith/kun/pkg/log"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// ACMEService obtains and renews the certificates of the https upstreams
+// over ACME. The TLS-ALPN-01 challenge is answered by GetCertificate on the
+// https frontend and the HTTP-01 challenge by HTTPHandler on the http one.
+type ACMEService struct {
+	manager   *autocert.Manager
+	upstreams *UpstreamService
+}
+
+// GetCertificate returns the certificate of the server name, obtaining it
+// first if needed, or the certificate answering a TLS-ALPN-01 challenge
+func (s *ACMEService) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return s.manager.GetCertificate(hello)
+}
+
+// HTTPHandler answers the HTTP-01 challenges and passes the other requests
+// to fallback
+func (s *ACMEService) HTTPHandler(fallback http.Handler) http.Handler {
+	return s.manager.HTTPHandler(fallback)
+}
+
+// Manages reports whether the certificate of hostname is managed over ACME
+func (s *ACMEService) Manages(hostname string) bool {
+	return s.hostPolicy(context.Background(), hostname) == nil
+}
+
+// Obtain loads the certificate of hostname from the cache or obtains it,
+// its renewal is scheduled from then on
+func (s *ACMEService) Obtain(hostname string) error {
+	// pretend a modern client so that an ECDSA certificate is obtained
+	_, err := s.manager.GetCertificate(&tls.ClientHelloInfo{
+		ServerName:       hostname,
+		CipherSuites:     []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+		SignatureSchemes: []tls.SignatureScheme{tls.ECDSAWithP256AndSHA256},
+		SupportedCurves:  []tls.CurveID{tls.CurveP256},
+	})
+	return err
+}
+
+// hostPolicy allows the hostnames of the registered https upstreams only
+func (s *ACMEService) hostPolicy(_ context.Context, hostname string) error {
+	u, err := s.upstreams.Get(hostname)
+	if err != nil {
+		return err
+	}
+
+	if u.Protocol != model.ProtocolHTTPS {
+		return fmt.Errorf("upstream %s is not served over https", hostname)
+	}
+	return nil
+}
+
+// onRegister obtains the certificate of an https upstream once it is
+// registered, the certificates of the subdomains of a wildcard upstream
+// are obtained on their first handshake
+func (s *ACMEService) onRegister(u *Upstream) {
+	if u.Protocol != model.ProtocolHTTPS {
+		return
+	}
+
+	l := log.FromContext(context.Background()).Sugar()
+
+	if strings.HasPrefix(u.DomainName, "*.") {
+		l.Infof("certificates of %s are obtained on demand unless a wildcard certificate is uploaded", u.DomainName)
+		return
+	}
+
+	if err := s.Obtain(u.DomainName); err != nil {
+		l.Warnf("unable obtain certificate of %s, got: %v", u.DomainName, err)
+		return
+	}
+	l.Infof("certificate of %s is ready", u.DomainName)
+}
+
+// newACMEHTTPClient create the client of the ACME directory which trusts
+// the certificate authorities in caFile besides the system ones
+func newACMEHTTPClient(caFile string) (*http.Client, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok := pool.AppendCertsFromPEM(data); !ok {
+		return nil, fmt.Errorf("no certificate found in %s", caFile)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	return &http.Client{Transport: transport, Timeout: time.Minute}, nil
+}
+
+// NewACMEService create ACMEService managing the certificates of the https
+// upstreams, the account key and certificates are persisted in opts.CacheDir
+func NewACMEService(opts *config.ACMEOptions, upstreams *UpstreamService) (*ACMEService, error) {
+	client := &acme.Client{DirectoryURL: opts.DirectoryURL}
+
+	if opts.TrustedCAFile != "" {
+		httpClient, err := newACMEHTTPClient(opts.TrustedCAFile)
+		if err != nil {
+			return nil, err
+		}
+		client.HTTPClient = httpClient
+	}
+
+	s := &ACMEService{upstreams: upstreams}
+
+	s.manager = &autocert.Manager{
+		Prompt:      autocert.AcceptTOS,
+		Cache:       autocert.DirCache(opts.CacheDir),
+		HostPolicy:  s.hostPolicy,
+		RenewBefore: time.Duration(opts.RenewBefore),
+		Client:      client,
+		Email:       opts.Email,
+	}
+
+	upstreams.OnRegister(s.onRegister)
+	return s, nil
+}
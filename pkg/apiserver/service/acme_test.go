@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"context"
+	"crypto/tls"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// watch registers hostname with protocol until ctx is done
+func watch(ctx context.Context, t *testing.T, upstreams *service.UpstreamService, hostname, protocol string) {
+	request := &v1.WatchTunnelsRequest{Hostname: hostname, Protocol: protocol}
+
+	go func() {
+		_ = upstreams.Watch(ctx, "admin", request, func(*v1.WatchTunnelsResponse) error { return nil })
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, err := upstreams.Get(hostname); err == nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s was not registered", hostname)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestACMEService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// an ACME server which is down
+	directory := httptest.NewServer(http.NotFoundHandler())
+	defer directory.Close()
+
+	opts := &config.ACMEOptions{}
+	opts.SetDefaults()
+	opts.Enabled = true
+	opts.DirectoryURL = directory.URL
+	opts.CacheDir = t.TempDir()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, newKey), nil, nil, 0, "")
+
+	acmeService, err := service.NewACMEService(opts, upstreams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	watch(ctx, t, upstreams, "secure.example.com", "HTTPS")
+	watch(ctx, t, upstreams, "plain.example.com", "HTTP")
+	watch(ctx, t, upstreams, "uploaded.example.com", "HTTPS")
+
+	if !acmeService.Manages("secure.example.com") {
+		t.Fatal("expected the certificate of an https upstream managed over ACME")
+	}
+
+	if acmeService.Manages("plain.example.com") || acmeService.Manages("unknown.example.com") {
+		t.Fatal("expected only the https upstreams managed over ACME")
+	}
+
+	year := time.Now().Add(time.Hour * 24 * 365)
+
+	certPEM, keyPEM := newCertificate(t, year, "default.example.com")
+	fallback, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := service.NewCertificateService(service.NewMemoryCertificateStore(), acmeService, &fallback)
+
+	certPEM, keyPEM = newCertificate(t, year, "uploaded.example.com")
+	if _, err := s.Upload(ctx, "uploaded.example.com", certPEM, keyPEM); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		// the uploaded certificate is preferred over ACME
+		"uploaded.example.com": "uploaded.example.com",
+		// the ACME server is down
+		"secure.example.com": "default.example.com",
+		"plain.example.com":  "default.example.com",
+	}
+
+	for serverName, expected := range tests {
+		certificate, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: serverName})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if name := commonName(t, certificate); name != expected {
+			t.Errorf("expected the certificate of %s for %q, got %s", expected, serverName, name)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"net"
+)
+
+// upstreamGroup the upstreams of the clients watching the same hostname and
+// path prefix, they share the protocol, the public port and the balancing
+// policy. A route without policy has one upstream only.
+type upstreamGroup struct {
+	prefix     string
+	policy     string
+	members    []*Upstream
+	listener   net.Listener
+	packetConn net.PacketConn
+
+	// next the member the round-robin starts from
+	next int
+}
+
+// candidates returns the healthy members whose client is connected, all the
+// healthy ones if none is, e.g. while they all wait to be resumed
+func (g *upstreamGroup) candidates() []*Upstream {
+	healthy := make([]*Upstream, 0, len(g.members))
+	live := make([]*Upstream, 0, len(g.members))
+
+	for _, u := range g.members {
+		if u.Status == model.UpstreamStatusUnhealthy {
+			continue
+		}
+
+		healthy = append(healthy, u)
+		if u.watch != 0 {
+			live = append(live, u)
+		}
+	}
+
+	if len(live) == 0 {
+		return healthy
+	}
+	return live
+}
+
+// pick chooses the member the next tunnel is taken from, nil if every
+// member is unhealthy
+func (g *upstreamGroup) pick() *Upstream {
+	members := g.candidates()
+
+	switch len(members) {
+	case 0:
+		return nil
+	case 1:
+		return members[0]
+	}
+
+	switch g.policy {
+	case model.LoadBalancingLeastConnections:
+		return g.pickLeastConnections(members)
+	case model.LoadBalancingWeighted:
+		return g.pickWeighted(members)
+	default:
+		g.next++
+		return members[g.next%len(members)]
+	}
+}
+
+// pickLeastConnections chooses the member with the fewest tunnels in use,
+// the ties are broken by turns
+func (g *upstreamGroup) pickLeastConnections(members []*Upstream) *Upstream {
+	g.next++
+
+	var chosen *Upstream
+	least := 0
+
+	for i := range members {
+		u := members[(g.next+i)%len(members)]
+
+		stats := u.pool.Stats()
+		if busy := stats.Active + stats.Waiting; chosen == nil || busy < least {
+			chosen, least = u, busy
+		}
+	}
+	return chosen
+}
+
+// pickWeighted chooses the members in proportion to their weight, spread
+// evenly over the turns (smooth weighted round-robin)
+func (g *upstreamGroup) pickWeighted(members []*Upstream) *Upstream {
+	var chosen *Upstream
+	total := 0
+
+	for _, u := range members {
+		weight := int(u.Weight)
+		if weight <= 0 {
+			weight = 1
+		}
+
+		u.currentWeight += weight
+		total += weight
+
+		if chosen == nil || u.currentWeight > chosen.currentWeight {
+			chosen = u
+		}
+	}
+
+	chosen.currentWeight -= total
+	return chosen
+}
+
+// remove drops u out of the members, it reports whether u was a member
+func (g *upstreamGroup) remove(u *Upstream) bool {
+	for i, m := range g.members {
+		if m == u {
+			g.members = append(g.members[:i], g.members[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/aapelismith/kun/pkg/log"
+	"golang.org/x/crypto/acme"
+	"strings"
+	"sync"
+	"time"
+)
+
+// certificateCacheTTL how long a certificate looked up in the store is
+// served before looking it up again, so certificates replaced on disk are
+// picked up without restart
+const certificateCacheTTL = time.Minute
+
+// ErrInvalidCertificate the uploaded certificate is unusable
+var ErrInvalidCertificate = errors.New("invalid certificate")
+
+// cachedCertificate a certificate of the store
+type cachedCertificate struct {
+	certificate *tls.Certificate
+	expiredAt   time.Time
+}
+
+// CertificateService selects the certificate of the https frontend by the
+// server name of the client hello
+type CertificateService struct {
+	store    CertificateStore
+	acme     *ACMEService
+	fallback *tls.Certificate
+
+	mu    sync.RWMutex
+	cache map[string]*cachedCertificate
+}
+
+// GetCertificate implements tls.Config.GetCertificate. The certificate of
+// the server name is preferred over the wildcard certificate of its parent
+// domain, then over the certificate obtained over ACME, the default
+// certificate is returned when there is none.
+func (s *CertificateService) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if s.acme != nil && len(hello.SupportedProtos) == 1 && hello.SupportedProtos[0] == acme.ALPNProto {
+		// a TLS-ALPN-01 challenge of the ACME server
+		return s.acme.GetCertificate(hello)
+	}
+
+	if hello.ServerName != "" {
+		hostname := NormalizeHostname(hello.ServerName)
+		candidates := []string{hostname}
+
+		if i := strings.IndexByte(hostname, '.'); i > 0 {
+			candidates = append(candidates, "*"+hostname[i:])
+		}
+
+		for _, candidate := range candidates {
+			certificate, err := s.lookup(hello.Context(), candidate)
+			if err != nil {
+				return nil, err
+			}
+
+			if certificate != nil {
+				return certificate, nil
+			}
+		}
+
+		if s.acme != nil && s.acme.Manages(hostname) {
+			certificate, err := s.acme.GetCertificate(hello)
+			if err == nil {
+				return certificate, nil
+			}
+			log.FromContext(hello.Context()).Sugar().Warnf("unable obtain certificate of %s, got: %v", hostname, err)
+		}
+	}
+
+	if s.fallback != nil {
+		return s.fallback, nil
+	}
+	return nil, fmt.Errorf("no certificate for %q", hello.ServerName)
+}
+
+// Upload verifies that the certificate covers hostname and stores it
+func (s *CertificateService) Upload(ctx context.Context, hostname string, certPEM, keyPEM []byte) (*x509.Certificate, error) {
+	hostname = NormalizeHostname(hostname)
+
+	if hostname == "" || strings.ContainsAny(hostname, `/\`) || strings.Contains(hostname, "..") {
+		return nil, fmt.Errorf("%w: %q is no hostname", ErrInvalidCertificate, hostname)
+	}
+
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+	}
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+	}
+
+	if time.Now().After(leaf.NotAfter) {
+		return nil, fmt.Errorf("%w: expired at %s", ErrInvalidCertificate, leaf.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if err := verifyHostname(leaf, hostname); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+	}
+
+	if err := s.store.Put(ctx, hostname, certPEM, keyPEM); err != nil {
+		return nil, err
+	}
+
+	certificate.Leaf = leaf
+
+	s.mu.Lock()
+	s.cache[hostname] = &cachedCertificate{certificate: &certificate, expiredAt: time.Now().Add(certificateCacheTTL)}
+	s.mu.Unlock()
+
+	return leaf, nil
+}
+
+// lookup returns the certificate of hostname, nil if the store has none.
+// Only the certificates found are cached.
+func (s *CertificateService) lookup(ctx context.Context, hostname string) (*tls.Certificate, error) {
+	s.mu.RLock()
+	cached, ok := s.cache[hostname]
+	s.mu.RUnlock()
+
+	if ok && time.Now().Before(cached.expiredAt) {
+		return cached.certificate, nil
+	}
+
+	certPEM, keyPEM, err := s.store.Get(ctx, hostname)
+	if err != nil && !errors.Is(err, ErrCertificateNotFound) {
+		return nil, err
+	}
+
+	var certificate *tls.Certificate
+
+	if err == nil {
+		c, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			// keep serving the certificate loaded before, if any
+			log.FromContext(ctx).Sugar().Errorf("unable load certificate of %s, got: %v", hostname, err)
+			if ok {
+				certificate = cached.certificate
+			}
+		} else {
+			certificate = &c
+		}
+	}
+
+	s.mu.Lock()
+	if certificate != nil {
+		s.cache[hostname] = &cachedCertificate{certificate: certificate, expiredAt: time.Now().Add(certificateCacheTTL)}
+	} else {
+		// the misses are not cached, any server name may be sent by a client
+		delete(s.cache, hostname)
+	}
+	s.mu.Unlock()
+
+	return certificate, nil
+}
+
+// verifyHostname checks that leaf is valid for hostname, a wildcard
+// hostname must be listed as is in the names of the certificate
+func verifyHostname(leaf *x509.Certificate, hostname string) error {
+	if !strings.HasPrefix(hostname, "*.") {
+		return leaf.VerifyHostname(hostname)
+	}
+
+	for _, name := range leaf.DNSNames {
+		if strings.EqualFold(name, hostname) {
+			return nil
+		}
+	}
+	return fmt.Errorf("certificate is not valid for %s", hostname)
+}
+
+// NewCertificateService create CertificateService on top of store and
+// acmeService, fallback is served when no certificate matches, acmeService
+// and fallback may be nil
+func NewCertificateService(store CertificateStore, acmeService *ACMEService, fallback *tls.Certificate) *CertificateService {
+	return &CertificateService{
+		store:    store,
+		acme:     acmeService,
+		fallback: fallback,
+		cache:    make(map[string]*cachedCertificate),
+	}
+}
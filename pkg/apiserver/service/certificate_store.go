@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrCertificateNotFound no certificate is stored for the hostname
+var ErrCertificateNotFound = errors.New("certificate not found")
+
+// CertificateStore persists the PEM encoded certificates of the hostnames,
+// a wildcard certificate is stored under its "*." prefixed hostname
+type CertificateStore interface {
+	// Get returns the certificate chain and private key of hostname
+	Get(ctx context.Context, hostname string) (certPEM, keyPEM []byte, err error)
+
+	// Put stores the certificate chain and private key of hostname
+	Put(ctx context.Context, hostname string, certPEM, keyPEM []byte) error
+}
+
+// MemoryCertificateStore keeps the certificates in memory
+type MemoryCertificateStore struct {
+	mu           sync.RWMutex
+	certificates map[string][2][]byte
+}
+
+// Get implements CertificateStore
+func (s *MemoryCertificateStore) Get(_ context.Context, hostname string) ([]byte, []byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pair, ok := s.certificates[hostname]
+	if !ok {
+		return nil, nil, ErrCertificateNotFound
+	}
+	return pair[0], pair[1], nil
+}
+
+// Put implements CertificateStore
+func (s *MemoryCertificateStore) Put(_ context.Context, hostname string, certPEM, keyPEM []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.certificates[hostname] = [2][]byte{certPEM, keyPEM}
+	return nil
+}
+
+// NewMemoryCertificateStore create an empty MemoryCertificateStore
+func NewMemoryCertificateStore() *MemoryCertificateStore {
+	return &MemoryCertificateStore{certificates: make(map[string][2][]byte)}
+}
+
+// FileCertificateStore keeps the certificates in a directory, the chain and
+// key of a hostname are the files <hostname>.crt and <hostname>.key where
+// the '*' of a wildcard hostname is written as '_'
+type FileCertificateStore struct {
+	dir string
+}
+
+// Get implements CertificateStore, no certificate is stored for a hostname
+// which is no file name in the directory
+func (s *FileCertificateStore) Get(_ context.Context, hostname string) ([]byte, []byte, error) {
+	certFile, keyFile, err := s.files(hostname)
+	if err != nil {
+		return nil, nil, ErrCertificateNotFound
+	}
+
+	certPEM, err := os.ReadFile(certFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, ErrCertificateNotFound
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPEM, err := os.ReadFile(keyFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, ErrCertificateNotFound
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+	return certPEM, keyPEM, nil
+}
+
+// Put implements CertificateStore, the chain and key are written to temporary
+// files first and only renamed over the stored ones once both are written, so
+// a failed Put leaves the stored pair untouched. The two renames are no single
+// step though: a Get in between reads the new key with the old chain, which
+// does not load as a pair and CertificateService keeps the certificate it
+// loaded before until the chain is renamed too.
+func (s *FileCertificateStore) Put(_ context.Context, hostname string, certPEM, keyPEM []byte) error {
+	certFile, keyFile, err := s.files(hostname)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+
+	keyTemp, err := writeTempFile(keyFile, keyPEM, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(keyTemp) }()
+
+	certTemp, err := writeTempFile(certFile, certPEM, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(certTemp) }()
+
+	if err := os.Rename(keyTemp, keyFile); err != nil {
+		return err
+	}
+	return os.Rename(certTemp, certFile)
+}
+
+// files returns the chain and key files of hostname
+func (s *FileCertificateStore) files(hostname string) (string, string, error) {
+	certFile, err := s.path(hostname, ".crt")
+	if err != nil {
+		return "", "", err
+	}
+
+	keyFile, err := s.path(hostname, ".key")
+	if err != nil {
+		return "", "", err
+	}
+	return certFile, keyFile, nil
+}
+
+// path returns the file of hostname with ext, an error if the file would not
+// be right in the directory
+func (s *FileCertificateStore) path(hostname, ext string) (string, error) {
+	name := filepath.Join(s.dir, strings.Replace(hostname, "*", "_", 1)+ext)
+	if filepath.Dir(name) != filepath.Clean(s.dir) {
+		return "", fmt.Errorf("hostname %q is no file name in %s", hostname, s.dir)
+	}
+	return name, nil
+}
+
+// writeTempFile writes data to a new temporary file next to the file name
+// and returns its name, the caller renames or removes it
+func writeTempFile(name string, data []byte, perm os.FileMode) (string, error) {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Chmod(perm)
+	}
+
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// NewFileCertificateStore create FileCertificateStore in dir
+func NewFileCertificateStore(dir string) *FileCertificateStore {
+	return &FileCertificateStore{dir: dir}
+}
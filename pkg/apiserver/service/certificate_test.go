@@ -0,0 +1,246 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newCertificate create a self-signed certificate for names
+func newCertificate(t *testing.T, notAfter time.Time, names ...string) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: names[0]},
+		DNSNames:     names,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// commonName returns the common name of the leaf of certificate
+func commonName(t *testing.T, certificate *tls.Certificate) string {
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf.Subject.CommonName
+}
+
+func TestCertificateService_GetCertificate(t *testing.T) {
+	ctx := context.Background()
+	year := time.Now().Add(time.Hour * 24 * 365)
+
+	certPEM, keyPEM := newCertificate(t, year, "default.example.com")
+	fallback, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := service.NewFileCertificateStore(t.TempDir())
+	s := service.NewCertificateService(store, nil, &fallback)
+
+	certPEM, keyPEM = newCertificate(t, year, "www.example.com")
+	if _, err := s.Upload(ctx, "WWW.example.com", certPEM, keyPEM); err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM, keyPEM = newCertificate(t, year, "*.dev.example.com")
+	if _, err := s.Upload(ctx, "*.dev.example.com", certPEM, keyPEM); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"www.example.com":   "www.example.com",
+		"a.dev.example.com": "*.dev.example.com",
+		"b.a.dev.example":   "default.example.com",
+		"example.com":       "default.example.com",
+		"":                  "default.example.com",
+	}
+
+	for serverName, expected := range tests {
+		certificate, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: serverName})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if name := commonName(t, certificate); name != expected {
+			t.Errorf("expected the certificate of %s for %q, got %s", expected, serverName, name)
+		}
+	}
+	// a miss is not cached, the certificate stored afterwards is served at once
+	certPEM, keyPEM = newCertificate(t, year, "example.com")
+	if err := store.Put(ctx, "example.com", certPEM, keyPEM); err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name := commonName(t, certificate); name != "example.com" {
+		t.Fatalf("expected the certificate of example.com, got %s", name)
+	}
+}
+
+func TestCertificateService_Upload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	year := time.Now().Add(time.Hour * 24 * 365)
+
+	s := service.NewCertificateService(service.NewFileCertificateStore(dir), nil, nil)
+
+	certPEM, keyPEM := newCertificate(t, year, "www.example.com")
+	if _, err := s.Upload(ctx, "api.example.com", certPEM, keyPEM); !errors.Is(err, service.ErrInvalidCertificate) {
+		t.Fatalf("expected the certificate rejected for another hostname, got %v", err)
+	}
+
+	_, otherKeyPEM := newCertificate(t, year, "www.example.com")
+	if _, err := s.Upload(ctx, "www.example.com", certPEM, otherKeyPEM); !errors.Is(err, service.ErrInvalidCertificate) {
+		t.Fatalf("expected the certificate rejected with a wrong key, got %v", err)
+	}
+
+	expiredPEM, expiredKeyPEM := newCertificate(t, time.Now().Add(-time.Minute), "www.example.com")
+	if _, err := s.Upload(ctx, "www.example.com", expiredPEM, expiredKeyPEM); !errors.Is(err, service.ErrInvalidCertificate) {
+		t.Fatalf("expected the expired certificate rejected, got %v", err)
+	}
+
+	if _, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: "www.example.com"}); err == nil {
+		t.Fatal("expected no certificate without default certificate")
+	}
+
+	leaf, err := s.Upload(ctx, "www.example.com", certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(leaf.DNSNames) != 1 || leaf.DNSNames[0] != "www.example.com" {
+		t.Fatalf("unexpected names %v", leaf.DNSNames)
+	}
+
+	// the certificate is persisted in the directory
+	reloaded := service.NewCertificateService(service.NewFileCertificateStore(dir), nil, nil)
+
+	certificate, err := reloaded.GetCertificate(&tls.ClientHelloInfo{ServerName: "www.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name := commonName(t, certificate); name != "www.example.com" {
+		t.Fatalf("unexpected certificate %s", name)
+	}
+}
+
+func TestCertificateService_UploadOutsideDir(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	dir := filepath.Join(root, "certificates")
+	year := time.Now().Add(time.Hour * 24 * 365)
+
+	store := service.NewFileCertificateStore(dir)
+	s := service.NewCertificateService(store, nil, nil)
+
+	hostname := "*./../../escaped.example.com"
+
+	// a self-signed certificate may list any name
+	certPEM, keyPEM := newCertificate(t, year, hostname)
+	if _, err := s.Upload(ctx, hostname, certPEM, keyPEM); !errors.Is(err, service.ErrInvalidCertificate) {
+		t.Fatalf("expected the hostname rejected, got %v", err)
+	}
+
+	if err := store.Put(ctx, hostname, certPEM, keyPEM); err == nil {
+		t.Fatal("expected the store to refuse a file outside its directory")
+	}
+
+	if _, _, err := store.Get(ctx, "../escaped.example.com"); !errors.Is(err, service.ErrCertificateNotFound) {
+		t.Fatalf("expected no certificate outside the directory, got %v", err)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(root, "..", "escaped.example.com.*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(matches) != 0 {
+		t.Fatalf("unexpected files %v", matches)
+	}
+}
+
+func TestFileCertificateStore_Put(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	year := time.Now().Add(time.Hour * 24 * 365)
+
+	store := service.NewFileCertificateStore(dir)
+
+	for i := 0; i < 2; i++ {
+		certPEM, keyPEM := newCertificate(t, year, "www.example.com")
+		if err := store.Put(ctx, "www.example.com", certPEM, keyPEM); err != nil {
+			t.Fatal(err)
+		}
+
+		storedCertPEM, storedKeyPEM, err := store.Get(ctx, "www.example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(storedCertPEM) != string(certPEM) || string(storedKeyPEM) != string(keyPEM) {
+			t.Fatalf("expected the pair of put %d stored", i)
+		}
+	}
+
+	// no temporary file is left in the directory
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("expected the chain and key files only, got %d files", len(entries))
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrDomainClaimed the ownership of the domain is proven by another access key
+	ErrDomainClaimed = errors.New("domain is verified by another access key")
+
+	// ErrDomainNotFound the domain is not claimed by the access key or its claim expired
+	ErrDomainNotFound = errors.New("domain is not claimed")
+
+	// ErrDomainUnverified no DNS record of the domain proves its ownership
+	ErrDomainUnverified = errors.New("domain ownership is not proven")
+)
+
+// DomainService keeps the custom domains claimed by the access keys and
+// proves their ownership over DNS, either by a TXT record holding the token of
+// the claim or by a CNAME record of the domain pointing at the token under the
+// hostname of the edge. The hostnames outside the base domain of the server
+// are custom domains.
+type DomainService struct {
+	baseDomain      string
+	challengePrefix string
+	cnameTarget     string
+	claimTimeout    time.Duration
+	resolver        *net.Resolver
+
+	lock sync.Mutex
+	// domains the claims of a hostname by access key id
+	domains map[string]map[string]*model.Domain
+}
+
+// Custom reports whether hostname, or the domain of a wildcard hostname, is
+// outside the base domain of the server
+func (s *DomainService) Custom(hostname string) bool {
+	hostname = strings.TrimPrefix(NormalizeHostname(hostname), "*.")
+	return hostname != s.baseDomain && !strings.HasSuffix(hostname, "."+s.baseDomain)
+}
+
+// ChallengeName returns the name of the TXT record proving the ownership of hostname
+func (s *DomainService) ChallengeName(hostname string) string {
+	return s.challengePrefix + "." + NormalizeHostname(hostname)
+}
+
+// CNAMETarget returns the target of the CNAME record proving a claim by its
+// token, empty if the server accepts TXT records only
+func (s *DomainService) CNAMETarget(token string) string {
+	if s.cnameTarget == "" {
+		return ""
+	}
+	return token + "." + s.cnameTarget
+}
+
+// Claim starts the ownership verification of hostname by accessKeyId, the
+// pending claim of the same access key is returned as is
+func (s *DomainService) Claim(accessKeyId, hostname string) (model.Domain, error) {
+	hostname = NormalizeHostname(hostname)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	claims := s.claimsLocked(hostname)
+	for id, d := range claims {
+		if d.Status == model.DomainStatusVerified && id != accessKeyId {
+			return model.Domain{}, ErrDomainClaimed
+		}
+	}
+
+	if d, ok := claims[accessKeyId]; ok {
+		return *d, nil
+	}
+
+	token, err := newChallengeToken()
+	if err != nil {
+		return model.Domain{}, err
+	}
+
+	now := time.Now()
+	d := &model.Domain{
+		Hostname:    hostname,
+		Status:      model.DomainStatusPending,
+		AccessKeyId: accessKeyId,
+		Token:       token,
+		CreatedAt:   now,
+		ExpiredAt:   now.Add(s.claimTimeout),
+	}
+
+	if claims == nil {
+		claims = make(map[string]*model.Domain)
+		s.domains[hostname] = claims
+	}
+	claims[accessKeyId] = d
+	return *d, nil
+}
+
+// Verify looks up the DNS records of hostname claimed by accessKeyId and marks
+// the claim verified once they prove its ownership, the pending claims of the
+// other access keys are then dropped
+func (s *DomainService) Verify(ctx context.Context, accessKeyId, hostname string) (model.Domain, error) {
+	hostname = NormalizeHostname(hostname)
+
+	s.lock.Lock()
+	d, ok := s.claimsLocked(hostname)[accessKeyId]
+	if !ok {
+		s.lock.Unlock()
+		return model.Domain{}, ErrDomainNotFound
+	}
+	claim := *d
+	s.lock.Unlock()
+
+	if claim.Status == model.DomainStatusVerified {
+		return claim, nil
+	}
+
+	method, err := s.lookup(ctx, hostname, claim.Token)
+	if err != nil {
+		return model.Domain{}, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	claims := s.claimsLocked(hostname)
+	if d, ok = claims[accessKeyId]; !ok || d.Token != claim.Token {
+		return model.Domain{}, ErrDomainNotFound
+	}
+
+	for id, other := range claims {
+		if id == accessKeyId {
+			continue
+		}
+		if other.Status == model.DomainStatusVerified {
+			return model.Domain{}, ErrDomainClaimed
+		}
+		delete(claims, id)
+	}
+
+	d.Status = model.DomainStatusVerified
+	d.Method = method
+	d.VerifiedAt = time.Now()
+	d.ExpiredAt = time.Time{}
+	return *d, nil
+}
+
+// Verified returns true if the ownership of hostname is proven by accessKeyId,
+// the subdomains of a verified domain are not
+func (s *DomainService) Verified(accessKeyId, hostname string) bool {
+	hostname = NormalizeHostname(hostname)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	d, ok := s.domains[hostname][accessKeyId]
+	return ok && d.Status == model.DomainStatusVerified
+}
+
+// claimsLocked returns the claims of hostname after dropping the expired ones,
+// the lock must be held by the caller
+func (s *DomainService) claimsLocked(hostname string) map[string]*model.Domain {
+	claims := s.domains[hostname]
+
+	now := time.Now()
+	for id, d := range claims {
+		if d.Status == model.DomainStatusPending && now.After(d.ExpiredAt) {
+			delete(claims, id)
+		}
+	}
+
+	if claims != nil && len(claims) == 0 {
+		delete(s.domains, hostname)
+		return nil
+	}
+	return claims
+}
+
+// lookup returns the method of the DNS record of hostname proving the token
+func (s *DomainService) lookup(ctx context.Context, hostname, token string) (string, error) {
+	records, txtErr := s.resolver.LookupTXT(ctx, s.ChallengeName(hostname))
+	for _, record := range records {
+		if strings.TrimSpace(record) == token {
+			return model.VerificationTXT, nil
+		}
+	}
+
+	if txtErr == nil {
+		txtErr = fmt.Errorf("no TXT record of %s holds the token", s.ChallengeName(hostname))
+	}
+
+	if s.cnameTarget == "" {
+		return "", fmt.Errorf("%w: %v", ErrDomainUnverified, txtErr)
+	}
+
+	cname, err := s.resolver.LookupCNAME(ctx, hostname)
+	if err == nil && NormalizeHostname(cname) == s.CNAMETarget(token) {
+		return model.VerificationCNAME, nil
+	}
+
+	if err == nil {
+		err = fmt.Errorf("%s is an alias of %s", hostname, NormalizeHostname(cname))
+	}
+	return "", fmt.Errorf("%w: %v, %v", ErrDomainUnverified, txtErr, err)
+}
+
+// newChallengeToken returns a random token of 128 bits
+func newChallengeToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// NewDomainService create DomainService which queries the nameserver of the
+// options, or the system resolver if none. The hostnames outside baseDomain
+// are custom domains.
+func NewDomainService(opts *config.DomainOptions, baseDomain string) *DomainService {
+	resolver := net.DefaultResolver
+	if opts.Nameserver != "" {
+		nameserver := opts.Nameserver
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, nameserver)
+			},
+		}
+	}
+
+	return &DomainService{
+		baseDomain:      NormalizeHostname(baseDomain),
+		challengePrefix: opts.ChallengePrefix,
+		cnameTarget:     NormalizeHostname(opts.CNAMETarget),
+		claimTimeout:    time.Duration(opts.ClaimTimeout),
+		resolver:        resolver,
+		domains:         make(map[string]map[string]*model.Domain),
+	}
+}
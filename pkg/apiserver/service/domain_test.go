@@ -0,0 +1,292 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"golang.org/x/net/dns/dnsmessage"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// nameserver a local DNS stand-in answering the TXT and CNAME records set by the test
+type nameserver struct {
+	conn net.PacketConn
+
+	lock  sync.Mutex
+	txt   map[string][]string
+	cname map[string]string
+}
+
+func (n *nameserver) SetTXT(name string, values ...string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.txt[name+"."] = values
+}
+
+func (n *nameserver) SetCNAME(name, target string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.cname[name+"."] = target + "."
+}
+
+func (n *nameserver) serve() {
+	buf := make([]byte, 512)
+	for {
+		size, addr, err := n.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		var request dnsmessage.Message
+		if err := request.Unpack(buf[:size]); err != nil || len(request.Questions) == 0 {
+			continue
+		}
+
+		if reply, err := n.answer(request).Pack(); err == nil {
+			_, _ = n.conn.WriteTo(reply, addr)
+		}
+	}
+}
+
+func (n *nameserver) answer(request dnsmessage.Message) *dnsmessage.Message {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	q := request.Questions[0]
+	reply := &dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: request.ID, Response: true, Authoritative: true, RCode: dnsmessage.RCodeNameError},
+		Questions: request.Questions,
+	}
+
+	header := func(name dnsmessage.Name, typ dnsmessage.Type) dnsmessage.ResourceHeader {
+		return dnsmessage.ResourceHeader{Name: name, Type: typ, Class: dnsmessage.ClassINET, TTL: 60}
+	}
+
+	name := q.Name.String()
+	switch {
+	case q.Type == dnsmessage.TypeTXT && n.txt[name] != nil:
+		reply.RCode = dnsmessage.RCodeSuccess
+		for _, value := range n.txt[name] {
+			reply.Answers = append(reply.Answers, dnsmessage.Resource{
+				Header: header(q.Name, dnsmessage.TypeTXT),
+				Body:   &dnsmessage.TXTResource{TXT: []string{value}},
+			})
+		}
+	case n.cname[name] != "":
+		target := dnsmessage.MustNewName(n.cname[name])
+		reply.RCode = dnsmessage.RCodeSuccess
+		reply.Answers = append(reply.Answers, dnsmessage.Resource{
+			Header: header(q.Name, dnsmessage.TypeCNAME),
+			Body:   &dnsmessage.CNAMEResource{CNAME: target},
+		})
+		// the pure Go resolver learns the canonical name from the address lookups
+		if q.Type == dnsmessage.TypeA {
+			reply.Answers = append(reply.Answers, dnsmessage.Resource{
+				Header: header(target, dnsmessage.TypeA),
+				Body:   &dnsmessage.AResource{A: [4]byte{127, 0, 0, 1}},
+			})
+		}
+	}
+	return reply
+}
+
+func newNameserver(t *testing.T) *nameserver {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	n := &nameserver{conn: conn, txt: make(map[string][]string), cname: make(map[string]string)}
+	go n.serve()
+	return n
+}
+
+func newDomainService(n *nameserver) *service.DomainService {
+	opts := &config.DomainOptions{}
+	opts.SetDefaults()
+	opts.Enabled = true
+	opts.CNAMETarget = "edge.example.com"
+	opts.Nameserver = n.conn.LocalAddr().String()
+	return service.NewDomainService(opts, "example.com")
+}
+
+func TestDomainService_VerifyTXT(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	n := newNameserver(t)
+	domains := newDomainService(n)
+
+	d, err := domains.Claim("admin", "App.Customer.com.")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Hostname != "app.customer.com" || d.Status != model.DomainStatusPending || len(d.Token) != 32 {
+		t.Fatalf("unexpected claim %+v", d)
+	}
+
+	if name := domains.ChallengeName(d.Hostname); name != "_kun-challenge.app.customer.com" {
+		t.Fatalf("unexpected challenge name %s", name)
+	}
+
+	again, err := domains.Claim("admin", "app.customer.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if again.Token != d.Token {
+		t.Fatalf("expected the pending claim to be returned, got token %s", again.Token)
+	}
+
+	if _, err := domains.Verify(ctx, "admin", "app.customer.com"); !errors.Is(err, service.ErrDomainUnverified) {
+		t.Fatalf("expected unverified domain, got %v", err)
+	}
+
+	if domains.Verified("admin", "app.customer.com") {
+		t.Fatal("expected the domain not verified before its TXT record exists")
+	}
+
+	n.SetTXT("_kun-challenge.app.customer.com", "unrelated", d.Token)
+
+	d, err = domains.Verify(ctx, "admin", "app.customer.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Status != model.DomainStatusVerified || d.Method != model.VerificationTXT || d.VerifiedAt.IsZero() {
+		t.Fatalf("unexpected verified domain %+v", d)
+	}
+
+	if !domains.Verified("admin", "APP.customer.com") {
+		t.Fatal("expected the domain verified")
+	}
+
+	if domains.Verified("admin", "www.app.customer.com") {
+		t.Fatal("expected the subdomains of a verified domain not verified")
+	}
+}
+
+func TestDomainService_VerifyCNAME(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	n := newNameserver(t)
+	domains := newDomainService(n)
+
+	d, err := domains.Claim("admin", "shop.customer.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	target := domains.CNAMETarget(d.Token)
+	if target != d.Token+".edge.example.com" {
+		t.Fatalf("unexpected cname target %s", target)
+	}
+
+	n.SetCNAME("shop.customer.com", "edge.example.com")
+
+	if _, err := domains.Verify(ctx, "admin", "shop.customer.com"); !errors.Is(err, service.ErrDomainUnverified) {
+		t.Fatalf("expected unverified domain for a CNAME without the token, got %v", err)
+	}
+
+	n.SetCNAME("shop.customer.com", target)
+
+	d, err = domains.Verify(ctx, "admin", "shop.customer.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Method != model.VerificationCNAME || !domains.Verified("admin", "shop.customer.com") {
+		t.Fatalf("unexpected verified domain %+v", d)
+	}
+}
+
+func TestDomainService_ClaimedByAnother(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	n := newNameserver(t)
+	domains := newDomainService(n)
+
+	if _, err := domains.Verify(ctx, "admin", "app.customer.com"); !errors.Is(err, service.ErrDomainNotFound) {
+		t.Fatalf("expected domain not found, got %v", err)
+	}
+
+	mine, err := domains.Claim("admin", "app.customer.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	theirs, err := domains.Claim("guest", "app.customer.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mine.Token == theirs.Token {
+		t.Fatal("expected every claim to have its own token")
+	}
+
+	// the record of the one claim proves nothing about the other
+	n.SetTXT("_kun-challenge.app.customer.com", theirs.Token)
+
+	if _, err := domains.Verify(ctx, "admin", "app.customer.com"); !errors.Is(err, service.ErrDomainUnverified) {
+		t.Fatalf("expected unverified domain, got %v", err)
+	}
+
+	if _, err := domains.Verify(ctx, "guest", "app.customer.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := domains.Claim("admin", "app.customer.com"); !errors.Is(err, service.ErrDomainClaimed) {
+		t.Fatalf("expected domain claimed by another access key, got %v", err)
+	}
+
+	_, err = domains.Verify(ctx, "admin", "app.customer.com")
+	if !errors.Is(err, service.ErrDomainNotFound) || domains.Verified("admin", "app.customer.com") {
+		t.Fatalf("expected the pending claim dropped, got %v", err)
+	}
+
+	if !domains.Verified("guest", "app.customer.com") {
+		t.Fatal("expected the domain verified by guest")
+	}
+}
+
+func TestDomainService_Custom(t *testing.T) {
+	s := newDomainService(newNameserver(t))
+
+	for hostname, expected := range map[string]bool{
+		"example.com":         false,
+		"WWW.example.com.":    false,
+		"*.dev.example.com":   false,
+		"app.customer.com":    true,
+		"*.customer.com":      true,
+		"badexample.com":      true,
+		"example.com.evil.io": true,
+	} {
+		if s.Custom(hostname) != expected {
+			t.Errorf("expected %s custom %v", hostname, expected)
+		}
+	}
+}
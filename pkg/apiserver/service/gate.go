@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidCredentials the Basic credentials or the Bearer tokens of an
+// upstream are malformed, or its protocol is not gated
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// credentials the digests of the Basic credentials and the Bearer tokens
+// accepted from the clients of an upstream, the secrets themselves are not
+// kept
+type credentials struct {
+	basic  map[string][sha256.Size]byte
+	bearer [][sha256.Size]byte
+}
+
+// empty reports whether no credentials are required
+func (c *credentials) empty() bool {
+	return len(c.basic) == 0 && len(c.bearer) == 0
+}
+
+// equal reports whether c and o accept the same credentials
+func (c *credentials) equal(o *credentials) bool {
+	if len(c.basic) != len(o.basic) || len(c.bearer) != len(o.bearer) {
+		return false
+	}
+
+	for username, digest := range c.basic {
+		if other, ok := o.basic[username]; !ok || other != digest {
+			return false
+		}
+	}
+
+	for i := range c.bearer {
+		if c.bearer[i] != o.bearer[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// authorize reports whether r carries accepted credentials, every request
+// is authorized if none are required
+func (c *credentials) authorize(r *http.Request) bool {
+	if c.empty() {
+		return true
+	}
+
+	if username, password, ok := r.BasicAuth(); ok {
+		expected, found := c.basic[username]
+		digest := sha256.Sum256([]byte(password))
+		return found && subtle.ConstantTimeCompare(digest[:], expected[:]) == 1
+	}
+
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return false
+	}
+
+	digest := sha256.Sum256([]byte(strings.TrimSpace(token)))
+
+	authorized := false
+	for _, expected := range c.bearer {
+		if subtle.ConstantTimeCompare(digest[:], expected[:]) == 1 {
+			authorized = true
+		}
+	}
+	return authorized
+}
+
+// challenges returns the WWW-Authenticate challenges of the schemes accepted
+// for the hostname
+func (c *credentials) challenges(hostname string) []string {
+	var result []string
+	realm := strconv.Quote(hostname)
+
+	if len(c.basic) != 0 {
+		result = append(result, `Basic realm=`+realm+`, charset="UTF-8"`)
+	}
+
+	if len(c.bearer) != 0 {
+		result = append(result, `Bearer realm=`+realm)
+	}
+	return result
+}
+
+// compileCredentials returns the credentials of the username:password pairs
+// of basicAuth and the tokens of bearerTokens
+func compileCredentials(basicAuth, bearerTokens []string) (credentials, error) {
+	c := credentials{}
+
+	for i, pair := range basicAuth {
+		username, password, _ := strings.Cut(pair, ":")
+		if username == "" || password == "" {
+			return credentials{}, fmt.Errorf("%w: basic credentials %d must be username:password",
+				ErrInvalidCredentials, i)
+		}
+
+		if c.basic == nil {
+			c.basic = make(map[string][sha256.Size]byte, len(basicAuth))
+		}
+		c.basic[username] = sha256.Sum256([]byte(password))
+	}
+
+	for i, token := range bearerTokens {
+		if token == "" || strings.ContainsAny(token, " \t") {
+			return credentials{}, fmt.Errorf("%w: bearer token %d is malformed", ErrInvalidCredentials, i)
+		}
+		c.bearer = append(c.bearer, sha256.Sum256([]byte(token)))
+	}
+	return c, nil
+}
+
+// GateService the credentials the frontend requires from the clients of the
+// HTTP and HTTPS upstreams, those configured for a hostname by the server
+// take precedence over those the upstreams were registered with
+type GateService struct {
+	hostnames map[string]credentials
+}
+
+// Authorize reports whether r carries the credentials required from the
+// clients of u, the credentials are then removed from r so that the local
+// service never sees them. It returns the challenges to answer an
+// unauthorized request with otherwise.
+func (s *GateService) Authorize(u *Upstream, r *http.Request) (bool, []string) {
+	c := &u.credentials
+	if s != nil {
+		if configured, ok := s.hostnames[u.DomainName]; ok {
+			c = &configured
+		}
+	}
+
+	if c.empty() {
+		return true, nil
+	}
+
+	if !c.authorize(r) {
+		return false, c.challenges(u.DomainName)
+	}
+
+	r.Header.Del("Authorization")
+	return true, nil
+}
+
+// NewGateService create GateService with the credentials of opts, which are
+// validated already
+func NewGateService(opts *config.GateOptions) (*GateService, error) {
+	s := &GateService{hostnames: make(map[string]credentials, len(opts.Hostnames))}
+
+	for _, h := range opts.Hostnames {
+		c, err := compileCredentials(h.BasicAuth, h.BearerTokens)
+		if err != nil {
+			return nil, fmt.Errorf("unable compile credentials of %s, got: %w", h.Hostname, err)
+		}
+		s.hostnames[NormalizeHostname(h.Hostname)] = c
+	}
+	return s, nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"net/http"
+	"testing"
+)
+
+func TestGateService_Authorize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	request := &v1.WatchTunnelsRequest{Hostname: "preview.example.com", Protocol: "HTTP", PoolSize: 1,
+		BasicAuth: []string{"alice:s3cret"}, BearerTokens: []string{"0123456789abcdef"}}
+
+	id, err := watchMember(ctx, t, upstreams, "admin", request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := upstreams.Lookup("preview.example.com", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authorize := func(gates *service.GateService, set func(r *http.Request)) (bool, []string) {
+		r, err := http.NewRequest(http.MethodGet, "http://preview.example.com/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set(r)
+
+		ok, challenges := gates.Authorize(u, r)
+		if ok && r.Header.Get("Authorization") != "" {
+			t.Fatal("expected the credentials removed from the request")
+		}
+		return ok, challenges
+	}
+
+	if ok, challenges := authorize(nil, func(r *http.Request) {}); ok || len(challenges) != 2 {
+		t.Fatalf("expected an anonymous request challenged by both schemes, got %v", challenges)
+	}
+
+	if ok, _ := authorize(nil, func(r *http.Request) { r.SetBasicAuth("alice", "s3cret") }); !ok {
+		t.Fatal("expected the basic credentials accepted")
+	}
+
+	if ok, _ := authorize(nil, func(r *http.Request) { r.SetBasicAuth("alice", "guess") }); ok {
+		t.Fatal("expected a wrong password refused")
+	}
+
+	if ok, _ := authorize(nil, func(r *http.Request) { r.Header.Set("Authorization", "bearer 0123456789abcdef") }); !ok {
+		t.Fatal("expected the bearer token accepted")
+	}
+
+	// the credentials configured by the server take precedence
+	gates, err := service.NewGateService(&config.GateOptions{Hostnames: []config.HostnameCredentials{
+		{Hostname: "Preview.example.com", BasicAuth: []string{"admin:0ther"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, challenges := authorize(gates, func(r *http.Request) { r.SetBasicAuth("alice", "s3cret") }); ok ||
+		len(challenges) != 1 {
+		t.Fatalf("expected the credentials of the client refused, got %v", challenges)
+	}
+
+	if ok, _ := authorize(gates, func(r *http.Request) { r.SetBasicAuth("admin", "0ther") }); !ok {
+		t.Fatal("expected the credentials of the server accepted")
+	}
+
+	// the members of an upstream share its credentials
+	mismatch := &v1.WatchTunnelsRequest{Hostname: "preview.example.com", Protocol: "HTTP", PoolSize: 1,
+		BasicAuth: []string{"alice:other"}}
+	if _, err := watchMember(ctx, t, upstreams, "admin", mismatch); err == nil {
+		t.Fatal("expected a member with other credentials refused")
+	}
+
+	tcp := &v1.WatchTunnelsRequest{Hostname: "db.example.com", Protocol: "TCP", PoolSize: 1,
+		BearerTokens: []string{"0123456789abcdef"}}
+	if _, err := watchMember(ctx, t, upstreams, "admin", tcp); !errors.Is(err, service.ErrInvalidCredentials) {
+		t.Fatalf("expected invalid credentials, got %v", err)
+	}
+}
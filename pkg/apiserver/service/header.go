@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"net/http"
+	"regexp"
+)
+
+// ErrInvalidHeaderRule the header rule cannot be applied
+var ErrInvalidHeaderRule = errors.New("invalid header rule")
+
+// hostHeader the Host of a request, which is no field of its header
+const hostHeader = "Host"
+
+// headerRules the header rules of an upstream with their patterns compiled
+type headerRules struct {
+	rules    []model.HeaderRule
+	patterns []*regexp.Regexp
+}
+
+// apply rewrites h by the rules in order
+func (rs *headerRules) apply(h http.Header) {
+	for i, rule := range rs.rules {
+		switch rule.Action {
+		case model.HeaderActionAdd:
+			h.Add(rule.Name, rule.Value)
+		case model.HeaderActionSet:
+			h.Set(rule.Name, rule.Value)
+		case model.HeaderActionRemove:
+			h.Del(rule.Name)
+		case model.HeaderActionReplace:
+			values := h.Values(rule.Name)
+			for j, value := range values {
+				values[j] = rs.patterns[i].ReplaceAllString(value, rule.Value)
+			}
+		}
+	}
+}
+
+// RewriteRequest rewrites the headers and the Host of r forwarded to the
+// local service of the upstream by its request header rules
+func (u *Upstream) RewriteRequest(r *http.Request) {
+	if len(u.requestHeaders.rules) == 0 {
+		return
+	}
+
+	r.Header.Set(hostHeader, r.Host)
+	u.requestHeaders.apply(r.Header)
+
+	if host := r.Header.Get(hostHeader); host != "" {
+		r.Host = host
+	}
+	r.Header.Del(hostHeader)
+}
+
+// RewriteResponse rewrites the headers of the response of the local service
+// of the upstream by its response header rules
+func (u *Upstream) RewriteResponse(resp *http.Response) {
+	u.responseHeaders.apply(resp.Header)
+}
+
+// compileHeaderRules returns the rules of the requests, or of the responses,
+// of an upstream. The Host of the requests may only be set to a value or
+// replaced.
+func compileHeaderRules(rules []*v1.HeaderRule, request bool) (headerRules, error) {
+	var compiled headerRules
+
+	for _, rule := range rules {
+		r := model.HeaderRule{
+			Action: rule.Action,
+			Name:   http.CanonicalHeaderKey(rule.Name),
+			Value:  rule.Value,
+		}
+
+		if request && r.Name == hostHeader && (r.Action == model.HeaderActionAdd || r.Action == model.HeaderActionRemove ||
+			(r.Action == model.HeaderActionSet && r.Value == "")) {
+			return headerRules{}, fmt.Errorf("%w: the Host header may only be set or replaced", ErrInvalidHeaderRule)
+		}
+
+		var pattern *regexp.Regexp
+		if r.Action == model.HeaderActionReplace {
+			if rule.Pattern == "" {
+				return headerRules{}, fmt.Errorf("%w: %s %s requires a pattern", ErrInvalidHeaderRule, r.Action, r.Name)
+			}
+
+			var err error
+			if pattern, err = regexp.Compile(rule.Pattern); err != nil {
+				return headerRules{}, fmt.Errorf("%w: %v", ErrInvalidHeaderRule, err)
+			}
+			r.Pattern = rule.Pattern
+		}
+
+		compiled.rules = append(compiled.rules, r)
+		compiled.patterns = append(compiled.patterns, pattern)
+	}
+	return compiled, nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"golang.org/x/time/rate"
+	"math"
+	"sync"
+	"time"
+)
+
+// bucketIdleTimeout the token bucket of a hostname or an access key unused
+// for this long is dropped, it is full again anyway
+var bucketIdleTimeout = time.Minute * 10
+
+// bucket a token bucket, the last time it was used and the tunnels holding
+// it, which keep it from being dropped
+type bucket struct {
+	*rate.Limiter
+	lastUsed time.Time
+	refs     int
+}
+
+// buckets the token buckets of a scope by hostname or access key, all of
+// them filled at the same rate
+type buckets struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// get returns the token bucket of key, nil if the scope is unlimited
+func (b *buckets) get(key string) *rate.Limiter {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.getLocked(key).Limiter
+}
+
+// hold returns the token bucket of key which is kept until it is released,
+// nil if the scope is unlimited
+func (b *buckets) hold(key string) *bucket {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	v := b.getLocked(key)
+	v.refs++
+	return v
+}
+
+// release lets the bucket held by hold be dropped once unused
+func (b *buckets) release(v *bucket) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	v.refs--
+	v.lastUsed = time.Now()
+}
+
+// getLocked returns the token bucket of key, the idle buckets held by no
+// tunnel are dropped first
+func (b *buckets) getLocked(key string) *bucket {
+	now := time.Now()
+	if now.Sub(b.lastSweep) > bucketIdleTimeout {
+		for k, v := range b.buckets {
+			if v.refs == 0 && now.Sub(v.lastUsed) > bucketIdleTimeout {
+				delete(b.buckets, k)
+			}
+		}
+		b.lastSweep = now
+	}
+
+	v, ok := b.buckets[key]
+	if !ok {
+		v = &bucket{Limiter: rate.NewLimiter(b.limit, b.burst)}
+		b.buckets[key] = v
+	}
+	v.lastUsed = now
+	return v
+}
+
+// newBuckets create buckets filled at perSecond tokens holding burst tokens
+// at most, the requests per second rounded up if burst is 0. Nil if perSecond
+// is 0.
+func newBuckets(perSecond float64, burst int) *buckets {
+	if perSecond <= 0 {
+		return nil
+	}
+
+	if burst <= 0 {
+		burst = int(math.Ceil(perSecond))
+	}
+
+	return &buckets{
+		limit:     rate.Limit(perSecond),
+		burst:     burst,
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// bandwidth the token buckets of the bytes of a hostname and its access key
+type bandwidth []*rate.Limiter
+
+// WaitN implements tunnel.Limiter, n is taken from every bucket in chunks
+// of its burst
+func (b bandwidth) WaitN(ctx context.Context, n int) error {
+	for _, limiter := range b {
+		for left := n; left > 0; {
+			chunk := left
+			if burst := limiter.Burst(); chunk > burst {
+				chunk = burst
+			}
+
+			if err := limiter.WaitN(ctx, chunk); err != nil {
+				return err
+			}
+			left -= chunk
+		}
+	}
+	return nil
+}
+
+// LimitService keeps the token buckets limiting the requests to the frontend
+// and the bytes moved through the tunnels by hostname and by access key
+type LimitService struct {
+	hostnameRequests  *buckets
+	accessKeyRequests *buckets
+	hostnameBytes     *buckets
+	accessKeyBytes    *buckets
+}
+
+// AllowRequest takes a request from the buckets of hostname and accessKeyId,
+// the request is refused with the delay after which it would be allowed if
+// any of them is empty
+func (s *LimitService) AllowRequest(hostname, accessKeyId string) (bool, time.Duration) {
+	now := time.Now()
+
+	var delay time.Duration
+	reservations := make([]*rate.Reservation, 0, 2)
+
+	for _, limiter := range []*rate.Limiter{s.hostnameRequests.get(hostname), s.accessKeyRequests.get(accessKeyId)} {
+		if limiter == nil {
+			continue
+		}
+
+		r := limiter.ReserveN(now, 1)
+		reservations = append(reservations, r)
+
+		if d := r.DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+
+	if delay == 0 {
+		return true, 0
+	}
+
+	// the refused request takes no token
+	for _, r := range reservations {
+		r.CancelAt(now)
+	}
+	return false, delay
+}
+
+// Bandwidth returns the limiter of the bytes moved through a tunnel of
+// hostname watched by accessKeyId, nil if they are unlimited. The buckets of
+// the limiter are kept until the returned func is called once the tunnel is
+// closed.
+func (s *LimitService) Bandwidth(hostname, accessKeyId string) (tunnel.Limiter, func()) {
+	var b bandwidth
+	var releases []func()
+
+	for _, scope := range []struct {
+		buckets *buckets
+		key     string
+	}{{s.hostnameBytes, hostname}, {s.accessKeyBytes, accessKeyId}} {
+		buckets := scope.buckets
+		if v := buckets.hold(scope.key); v != nil {
+			b = append(b, v.Limiter)
+			releases = append(releases, func() { buckets.release(v) })
+		}
+	}
+
+	release := func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+
+	if len(b) == 0 {
+		return nil, release
+	}
+	return b, release
+}
+
+// NewLimitService create LimitService with the limits of the options
+func NewLimitService(opts *config.LimitOptions) *LimitService {
+	return &LimitService{
+		hostnameRequests:  newBuckets(opts.HostnameRequestsPerSecond, opts.HostnameRequestBurst),
+		accessKeyRequests: newBuckets(opts.AccessKeyRequestsPerSecond, opts.AccessKeyRequestBurst),
+		hostnameBytes:     newBuckets(float64(opts.HostnameBytesPerSecond), 0),
+		accessKeyBytes:    newBuckets(float64(opts.AccessKeyBytesPerSecond), 0),
+	}
+}
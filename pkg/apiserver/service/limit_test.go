@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"context"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"testing"
+	"time"
+)
+
+func TestLimitService_AllowRequest(t *testing.T) {
+	limits := service.NewLimitService(&config.LimitOptions{
+		HostnameRequestsPerSecond:  1,
+		HostnameRequestBurst:       2,
+		AccessKeyRequestsPerSecond: 1,
+		AccessKeyRequestBurst:      3,
+	})
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := limits.AllowRequest("a.example.com", "admin"); !ok {
+			t.Fatalf("expected request %d allowed by the burst", i)
+		}
+	}
+
+	ok, delay := limits.AllowRequest("a.example.com", "admin")
+	if ok || delay <= 0 || delay > time.Second {
+		t.Fatalf("expected the hostname over its limit for at most a second, got %v %s", ok, delay)
+	}
+
+	// the refused request took no token of the access key
+	if ok, _ := limits.AllowRequest("b.example.com", "admin"); !ok {
+		t.Fatal("expected another hostname of the access key allowed")
+	}
+
+	if ok, _ := limits.AllowRequest("c.example.com", "admin"); ok {
+		t.Fatal("expected the access key over its limit")
+	}
+
+	if ok, _ := limits.AllowRequest("c.example.com", "guest"); !ok {
+		t.Fatal("expected another access key allowed")
+	}
+
+	unlimited := service.NewLimitService(&config.LimitOptions{})
+	for i := 0; i < 100; i++ {
+		if ok, _ := unlimited.AllowRequest("a.example.com", "admin"); !ok {
+			t.Fatal("expected no limit")
+		}
+	}
+
+	limiter, release := unlimited.Bandwidth("a.example.com", "admin")
+	defer release()
+
+	if limiter != nil {
+		t.Fatal("expected no bandwidth limit")
+	}
+}
+
+func TestLimitService_Bandwidth(t *testing.T) {
+	limits := service.NewLimitService(&config.LimitOptions{HostnameBytesPerSecond: 1000})
+
+	limiter, release := limits.Bandwidth("a.example.com", "admin")
+	defer release()
+
+	if limiter == nil {
+		t.Fatal("expected a bandwidth limit")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// one second worth of bytes at once, the next half second later
+	start := time.Now()
+	if err := limiter.WaitN(ctx, 1500); err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Millisecond*400 || elapsed > time.Second*2 {
+		t.Fatalf("expected 1500 bytes allowed in about half a second, took %s", elapsed)
+	}
+
+	// the tunnels of the hostname share its bucket
+	short, cancelShort := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancelShort()
+
+	other, releaseOther := limits.Bandwidth("a.example.com", "guest")
+	defer releaseOther()
+
+	if err := other.WaitN(short, 1000); err == nil {
+		t.Fatal("expected the bucket of the hostname empty")
+	}
+}
+
+func TestLimitService_BandwidthIdleSweep(t *testing.T) {
+	defer service.SetBucketIdleTimeout(time.Millisecond * 50)()
+
+	limits := service.NewLimitService(&config.LimitOptions{HostnameBytesPerSecond: 1000})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	limiter, release := limits.Bandwidth("a.example.com", "admin")
+	if err := limiter.WaitN(ctx, 1000); err != nil {
+		t.Fatal(err)
+	}
+
+	// the bucket held by a live tunnel outlives the idle sweep
+	time.Sleep(time.Millisecond * 100)
+
+	short, cancelShort := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancelShort()
+
+	other, releaseOther := limits.Bandwidth("a.example.com", "guest")
+	if err := other.WaitN(short, 1000); err == nil {
+		t.Fatal("expected the bucket of the hostname shared past the idle sweep")
+	}
+	releaseOther()
+	release()
+
+	// the bucket held by no tunnel is dropped, it is full again anyway
+	time.Sleep(time.Millisecond * 100)
+
+	fresh, releaseFresh := limits.Bandwidth("a.example.com", "admin")
+	defer releaseFresh()
+
+	fast, cancelFast := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancelFast()
+
+	if err := fresh.WaitN(fast, 1000); err != nil {
+		t.Fatalf("expected a full bucket, got: %v", err)
+	}
+}
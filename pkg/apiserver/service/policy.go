@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ErrInvalidAccessPolicy a range of the allow or deny list is no CIDR or address
+var ErrInvalidAccessPolicy = errors.New("invalid access policy")
+
+// accessPolicy the ranges of the client addresses allowed to reach an upstream
+type accessPolicy struct {
+	allow []*net.IPNet
+	deny  []*net.IPNet
+}
+
+// allowed reports whether ip is in no denied range and in an allowed range,
+// if any
+func (p *accessPolicy) allowed(ip net.IP) bool {
+	if ip == nil {
+		return len(p.allow) == 0 && len(p.deny) == 0
+	}
+
+	if ContainsIP(p.deny, ip) {
+		return false
+	}
+	return len(p.allow) == 0 || ContainsIP(p.allow, ip)
+}
+
+// Allowed reports whether the client at ip may reach the upstream
+func (u *Upstream) Allowed(ip net.IP) bool {
+	return u.policy.allowed(ip)
+}
+
+// ParseCIDRs parses the CIDR ranges of ranges, a single address is the
+// range of itself
+func ParseCIDRs(ranges []string) ([]*net.IPNet, error) {
+	result := make([]*net.IPNet, 0, len(ranges))
+
+	for _, r := range ranges {
+		if !strings.Contains(r, "/") {
+			ip := net.ParseIP(r)
+			if ip == nil {
+				return nil, fmt.Errorf("'%s' is no CIDR or address", r)
+			}
+
+			bits := net.IPv6len * 8
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, net.IPv4len*8
+			}
+			result = append(result, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(r)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is no CIDR or address", r)
+		}
+		result = append(result, ipNet)
+	}
+	return result, nil
+}
+
+// ContainsIP reports whether ip is in any of ranges
+func ContainsIP(ranges []*net.IPNet, ip net.IP) bool {
+	for _, r := range ranges {
+		if r.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// compileAccessPolicy returns the policy of the allow and deny lists with
+// their ranges in canonical form
+func compileAccessPolicy(allow, deny []string) (accessPolicy, []string, []string, error) {
+	allowNets, err := ParseCIDRs(allow)
+	if err != nil {
+		return accessPolicy{}, nil, nil, fmt.Errorf("%w: %v", ErrInvalidAccessPolicy, err)
+	}
+
+	denyNets, err := ParseCIDRs(deny)
+	if err != nil {
+		return accessPolicy{}, nil, nil, fmt.Errorf("%w: %v", ErrInvalidAccessPolicy, err)
+	}
+
+	return accessPolicy{allow: allowNets, deny: denyNets}, cidrStrings(allowNets), cidrStrings(denyNets), nil
+}
+
+// cidrStrings returns the canonical form of ranges, nil if there is none
+func cidrStrings(ranges []*net.IPNet) []string {
+	var result []string
+	for _, r := range ranges {
+		result = append(result, r.String())
+	}
+	return result
+}
+
+// ClientIP returns the address of the client of a connection from remoteAddr
+// which forwarded for the addresses of forwardedFor, the X-Forwarded-For
+// header. The forwarded addresses are trusted as long as they were added by
+// one of the trusted proxies: the rightmost address which is no trusted proxy
+// is the client.
+func ClientIP(remoteAddr string, forwardedFor []string, trustedProxies []*net.IPNet) net.IP {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ContainsIP(trustedProxies, ip) {
+		return ip
+	}
+
+	var hops []string
+	for _, value := range forwardedFor {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			// the chain of the trusted proxies ends at a malformed hop
+			return ip
+		}
+
+		ip = hop
+		if !ContainsIP(trustedProxies, ip) {
+			return ip
+		}
+	}
+	return ip
+}
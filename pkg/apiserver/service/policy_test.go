@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"net"
+	"testing"
+)
+
+func TestParseCIDRs(t *testing.T) {
+	ranges, err := service.ParseCIDRs([]string{"10.0.0.0/8", "192.0.2.1", "2001:db8::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"10.0.0.0/8", "192.0.2.1/32", "2001:db8::1/128"}
+	for i, r := range ranges {
+		if r.String() != expected[i] {
+			t.Fatalf("expected %s, got %s", expected[i], r)
+		}
+	}
+
+	if _, err := service.ParseCIDRs([]string{"10.0.0.0/33"}); err == nil {
+		t.Fatal("expected an error for an invalid range")
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	trusted, err := service.ParseCIDRs([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		remoteAddr   string
+		forwardedFor []string
+		expected     string
+	}{
+		// the header of an untrusted peer is ignored
+		{"192.0.2.1:4000", []string{"198.51.100.1"}, "192.0.2.1"},
+		{"10.0.0.1:4000", []string{"198.51.100.1"}, "198.51.100.1"},
+		// the address spoofed by the client is left of the one added by the proxy
+		{"10.0.0.1:4000", []string{"203.0.113.1, 198.51.100.1"}, "198.51.100.1"},
+		{"10.0.0.1:4000", []string{"198.51.100.1", "10.0.0.2"}, "198.51.100.1"},
+		{"10.0.0.1:4000", []string{"bogus"}, "10.0.0.1"},
+		{"10.0.0.1:4000", nil, "10.0.0.1"},
+	} {
+		if ip := service.ClientIP(c.remoteAddr, c.forwardedFor, trusted); ip.String() != c.expected {
+			t.Fatalf("expected %s for %s %v, got %s", c.expected, c.remoteAddr, c.forwardedFor, ip)
+		}
+	}
+}
+
+func TestUpstreamService_AccessPolicy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	request := &v1.WatchTunnelsRequest{Hostname: "staging.example.com", Protocol: "HTTP", PoolSize: 1,
+		AllowCidrs: []string{"203.0.113.0/24"}, DenyCidrs: []string{"203.0.113.66"}}
+
+	id, err := watchMember(ctx, t, upstreams, "admin", request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := upstreams.Lookup("staging.example.com", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for ip, expected := range map[string]bool{"203.0.113.10": true, "203.0.113.66": false, "198.51.100.1": false} {
+		if u.Allowed(net.ParseIP(ip)) != expected {
+			t.Fatalf("expected %s allowed %v", ip, expected)
+		}
+	}
+
+	if u.Allowed(nil) {
+		t.Fatal("expected an unknown client refused")
+	}
+
+	// the members of an upstream share its policy
+	mismatch := &v1.WatchTunnelsRequest{Hostname: "staging.example.com", Protocol: "HTTP", PoolSize: 1}
+	if _, err := watchMember(ctx, t, upstreams, "admin", mismatch); err == nil {
+		t.Fatal("expected a member with another policy refused")
+	}
+
+	invalid := &v1.WatchTunnelsRequest{Hostname: "invalid.example.com", Protocol: "HTTP", PoolSize: 1,
+		AllowCidrs: []string{"office"}}
+	if _, err := watchMember(ctx, t, upstreams, "admin", invalid); !errors.Is(err, service.ErrInvalidAccessPolicy) {
+		t.Fatalf("expected invalid access policy, got %v", err)
+	}
+}
@@ -0,0 +1,574 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"net"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrPoolClosed the upstream of the pool is no longer watched
+	ErrPoolClosed = errors.New("tunnel pool closed")
+
+	// ErrUnexpectedTunnel the tunnel was not requested by the pool or its token has expired
+	ErrUnexpectedTunnel = errors.New("unexpected tunnel")
+)
+
+// waitSmoothing the weight of the latest wait in the moving average of the
+// time the callers wait for a tunnel
+const waitSmoothing = 0.2
+
+// doner is implemented by the tunnels which tell when they are closed
+type doner interface {
+	Done() <-chan struct{}
+}
+
+// PoolStats the usage of a Pool and the figures its scaling is decided by
+type PoolStats struct {
+	Size       int
+	MinSize    int
+	MaxSize    int
+	Idle       int
+	Active     int
+	Pending    int
+	Waiting    int
+	Sessions   int
+	PeakActive int
+	Acquired   uint64
+	ScaleUps   uint64
+	ScaleDowns uint64
+
+	// AcquireWaitAvg the moving average of the time the callers waited for a tunnel
+	AcquireWaitAvg time.Duration
+
+	// AcquireWaitMax the longest wait since the pool was last scaled down
+	AcquireWaitMax time.Duration
+}
+
+// Pool keeps the tunnels connected by the client of an upstream. It asks the
+// client for more tunnels whenever the idle and requested tunnels are less
+// than its size plus the number of callers waiting for a tunnel. The sessions
+// of a multiplexed upstream count as idle tunnels, they open a stream for
+// every caller.
+//
+// The size of a pool with a max size follows the number of tunnels in use:
+// it doubles as soon as they approach the size, and Scale halves it, retiring
+// the idle tunnels beyond, when they stayed far below it since the last call.
+type Pool struct {
+	mu       sync.Mutex
+	size     int
+	minSize  int
+	maxSize  int
+	closed   bool
+	idle     []net.Conn
+	sessions []*tunnel.Session
+	waiters  []chan net.Conn
+	pending  map[string]*time.Timer
+	wakeup   chan struct{}
+	done     chan struct{}
+
+	// users the number of requests using each tracked tunnel, the tunnels
+	// with none are kept by their caller for reuse but are not active
+	users map[net.Conn]int
+
+	active     int
+	peakActive int
+	acquired   uint64
+	scaleUps   uint64
+	scaleDowns uint64
+	waitAvg    time.Duration
+	waitMax    time.Duration
+}
+
+// Wakeup is signaled whenever the pool may need more tunnels
+func (p *Pool) Wakeup() <-chan struct{} {
+	return p.wakeup
+}
+
+// Done is closed when the pool is closed
+func (p *Pool) Done() <-chan struct{} {
+	return p.done
+}
+
+// Deficit the number of tunnels that should be requested from the client
+func (p *Pool) Deficit() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return 0
+	}
+	return p.size + len(p.waiters) - len(p.idle) - len(p.sessions) - len(p.pending)
+}
+
+// Idle the number of connected tunnels waiting to be used
+func (p *Pool) Idle() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.idle)
+}
+
+// Stats returns the current usage of the pool
+func (p *Pool) Stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return PoolStats{
+		Size:           p.size,
+		MinSize:        p.minSize,
+		MaxSize:        p.maxSize,
+		Idle:           len(p.idle),
+		Active:         p.active,
+		Pending:        len(p.pending),
+		Waiting:        len(p.waiters),
+		Sessions:       len(p.sessions),
+		PeakActive:     p.peakActive,
+		Acquired:       p.acquired,
+		ScaleUps:       p.scaleUps,
+		ScaleDowns:     p.scaleDowns,
+		AcquireWaitAvg: p.waitAvg,
+		AcquireWaitMax: p.waitMax,
+	}
+}
+
+// Scale shrinks a pool with a max size by half, down to its min size, when
+// the tunnels in use stayed below a quarter of its size since the last call,
+// the idle tunnels beyond the new size are closed. It reports whether the
+// pool was scaled down. Scale is meant to be called periodically.
+func (p *Pool) Scale() bool {
+	p.mu.Lock()
+
+	if p.closed || p.maxSize == 0 {
+		p.mu.Unlock()
+		return false
+	}
+
+	peak := p.peakActive
+	p.peakActive, p.waitMax = p.active+len(p.waiters), 0
+
+	if peak*4 >= p.size || p.size <= p.minSize {
+		p.mu.Unlock()
+		return false
+	}
+
+	p.size /= 2
+	if p.size < p.minSize {
+		p.size = p.minSize
+	}
+	p.scaleDowns++
+
+	var retired []net.Conn
+	if len(p.idle) > p.size {
+		// the oldest tunnels go first, they are the next to be acquired otherwise
+		n := len(p.idle) - p.size
+		retired = append(retired, p.idle[:n]...)
+		p.idle = append([]net.Conn(nil), p.idle[n:]...)
+	}
+	p.mu.Unlock()
+
+	for _, conn := range retired {
+		_ = conn.Close()
+	}
+	return true
+}
+
+// Expect records a tunnel requested from the client, the request is
+// forgotten if the tunnel is not connected before expiredAt
+func (p *Pool) Expect(traceId string, expiredAt time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	p.pending[traceId] = time.AfterFunc(time.Until(expiredAt), func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if _, ok := p.pending[traceId]; ok {
+			delete(p.pending, traceId)
+			p.signal()
+		}
+	})
+}
+
+// Forget drops the tunnels requested from the client which are not
+// connected yet, e.g. when a new watch of the upstream takes over
+func (p *Pool) Forget() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for traceId, timer := range p.pending {
+		timer.Stop()
+		delete(p.pending, traceId)
+	}
+	p.signal()
+}
+
+// Put hands over the tunnel requested by traceId to a waiting caller, or
+// keeps it idle until it is acquired
+func (p *Pool) Put(traceId string, conn net.Conn) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.consume(traceId); err != nil {
+		return err
+	}
+
+	if len(p.waiters) > 0 {
+		ch := p.waiters[0]
+		p.waiters = p.waiters[1:]
+		ch <- conn
+		return nil
+	}
+
+	p.idle = append(p.idle, conn)
+	return nil
+}
+
+// PutSession keeps the multiplexed tunnel requested by traceId until it is
+// done, the waiting callers get a stream of it at once
+func (p *Pool) PutSession(traceId string, session *tunnel.Session) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.consume(traceId); err != nil {
+		return err
+	}
+
+	p.sessions = append(p.sessions, session)
+
+	for len(p.waiters) > 0 {
+		conn, err := session.Open()
+		if err != nil {
+			break
+		}
+
+		ch := p.waiters[0]
+		p.waiters = p.waiters[1:]
+		ch <- conn
+	}
+	return nil
+}
+
+// RemoveSession drops a multiplexed tunnel, e.g. when it is closed by the client
+func (p *Pool) RemoveSession(session *tunnel.Session) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, s := range p.sessions {
+		if s == session {
+			p.sessions = append(p.sessions[:i], p.sessions[i+1:]...)
+			p.signal()
+			return
+		}
+	}
+}
+
+// consume forgets the tunnel requested by traceId once it is connected,
+// must be called with p.mu held
+func (p *Pool) consume(traceId string) error {
+	if p.closed {
+		return ErrPoolClosed
+	}
+
+	timer, ok := p.pending[traceId]
+	if !ok {
+		return ErrUnexpectedTunnel
+	}
+
+	timer.Stop()
+	delete(p.pending, traceId)
+	return nil
+}
+
+// openStream opens a stream of the least busy session, must be called with p.mu held
+func (p *Pool) openStream() (net.Conn, bool) {
+	var session *tunnel.Session
+
+	for _, s := range p.sessions {
+		if session == nil || s.NumStreams() < session.NumStreams() {
+			session = s
+		}
+	}
+
+	if session == nil {
+		return nil, false
+	}
+
+	conn, err := session.Open()
+	if err != nil {
+		return nil, false
+	}
+	return conn, true
+}
+
+// Remove drops an idle tunnel, e.g. when it is closed by the client
+func (p *Pool) Remove(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, c := range p.idle {
+		if c == conn {
+			p.idle = append(p.idle[:i], p.idle[i+1:]...)
+			p.signal()
+			return
+		}
+	}
+}
+
+// Acquire opens a stream of a multiplexed tunnel or takes an idle tunnel
+// out of the pool, or waits for the client to connect one until ctx is done
+func (p *Pool) Acquire(ctx context.Context) (net.Conn, error) {
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+
+	if conn, ok := p.openStream(); ok {
+		p.track(conn, 0)
+		p.mu.Unlock()
+		return conn, nil
+	}
+
+	if len(p.idle) > 0 {
+		conn := p.idle[0]
+		p.idle = p.idle[1:]
+		p.track(conn, 0)
+		p.signal()
+		p.mu.Unlock()
+		return conn, nil
+	}
+
+	startedAt := time.Now()
+
+	ch := make(chan net.Conn, 1)
+	p.waiters = append(p.waiters, ch)
+	p.grow()
+	p.signal()
+	p.mu.Unlock()
+
+	select {
+	case conn, ok := <-ch:
+		if !ok {
+			return nil, ErrPoolClosed
+		}
+
+		p.mu.Lock()
+		p.track(conn, time.Since(startedAt))
+		p.signal()
+		p.mu.Unlock()
+		return conn, nil
+	case <-ctx.Done():
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, c := range p.waiters {
+		if c == ch {
+			p.waiters = append(p.waiters[:i], p.waiters[i+1:]...)
+			break
+		}
+	}
+
+	// a tunnel may have been handed over while we were giving up
+	select {
+	case conn, ok := <-ch:
+		if ok {
+			if p.closed {
+				_ = conn.Close()
+			} else {
+				p.idle = append(p.idle, conn)
+			}
+		}
+	default:
+	}
+	return nil, ctx.Err()
+}
+
+// Close closes the idle tunnels and wakes up the waiting callers
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil
+	}
+
+	p.closed = true
+	close(p.done)
+
+	for _, timer := range p.pending {
+		timer.Stop()
+	}
+
+	for _, ch := range p.waiters {
+		close(ch)
+	}
+
+	for _, conn := range p.idle {
+		_ = conn.Close()
+	}
+
+	for _, session := range p.sessions {
+		_ = session.Close()
+	}
+
+	p.idle, p.sessions, p.waiters, p.pending = nil, nil, nil, nil
+	return nil
+}
+
+// track counts conn as in use until it is released or closed and records
+// the time its caller waited for it, must be called with p.mu held
+func (p *Pool) track(conn net.Conn, wait time.Duration) {
+	p.acquired++
+
+	p.waitAvg += time.Duration(waitSmoothing * float64(wait-p.waitAvg))
+	if wait > p.waitMax {
+		p.waitMax = wait
+	}
+
+	d, ok := conn.(doner)
+	if !ok {
+		return
+	}
+
+	p.users[conn] = 1
+	p.active++
+	p.grow()
+
+	go func() {
+		<-d.Done()
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if p.users[conn] > 0 {
+			p.active--
+		}
+		delete(p.users, conn)
+	}()
+}
+
+// Hold counts the acquired tunnel conn as used by one more request, a tunnel
+// is active while any request uses it. The tunnels kept by their caller
+// between requests, e.g. by an http transport, are released then held
+// again for every request.
+func (p *Pool) Hold(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n, ok := p.users[conn]
+	if !ok {
+		return
+	}
+
+	p.users[conn] = n + 1
+	if n == 0 {
+		p.active++
+		p.grow()
+	}
+}
+
+// Release counts the acquired tunnel conn as used by one request less
+func (p *Pool) Release(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n, ok := p.users[conn]
+	if !ok || n == 0 {
+		return
+	}
+
+	p.users[conn] = n - 1
+	if n == 1 {
+		p.active--
+	}
+}
+
+// grow doubles the size of a pool with a max size, up to it, when the tunnels
+// in use and the waiting callers reach three quarters of the size, must be
+// called with p.mu held
+func (p *Pool) grow() {
+	busy := p.active + len(p.waiters)
+	if busy > p.peakActive {
+		p.peakActive = busy
+	}
+
+	if p.maxSize == 0 || p.size >= p.maxSize || busy*4 < p.size*3 {
+		return
+	}
+
+	size := p.size * 2
+	if size <= busy {
+		size = busy + 1
+	}
+
+	if size > p.maxSize {
+		size = p.maxSize
+	}
+
+	p.size = size
+	p.scaleUps++
+}
+
+// signal wakes up the watcher of the pool without blocking, must be
+// called with p.mu held
+func (p *Pool) signal() {
+	select {
+	case p.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// NewPool create a Pool which keeps size idle tunnels warm
+func NewPool(size int) *Pool {
+	return NewScalingPool(size, 0, 0)
+}
+
+// NewScalingPool create a Pool which keeps size idle tunnels warm at first,
+// then scales between minSize and maxSize. The size is fixed if maxSize is 0.
+func NewScalingPool(size, minSize, maxSize int) *Pool {
+	if maxSize > 0 {
+		if size < minSize {
+			size = minSize
+		}
+
+		if size > maxSize {
+			size = maxSize
+		}
+	}
+
+	p := &Pool{
+		size:    size,
+		minSize: minSize,
+		maxSize: maxSize,
+		pending: make(map[string]*time.Timer),
+		users:   make(map[net.Conn]int),
+		wakeup:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	p.signal()
+	return p
+}
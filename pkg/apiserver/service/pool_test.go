@@ -0,0 +1,382 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPool_Deficit(t *testing.T) {
+	p := service.NewPool(2)
+	defer p.Close()
+
+	if d := p.Deficit(); d != 2 {
+		t.Fatalf("expected deficit 2, got %d", d)
+	}
+
+	p.Expect("a", time.Now().Add(time.Minute))
+	p.Expect("b", time.Now().Add(time.Minute))
+
+	if d := p.Deficit(); d != 0 {
+		t.Fatalf("expected deficit 0, got %d", d)
+	}
+
+	c1, c2 := net.Pipe()
+	defer c2.Close()
+
+	if err := p.Put("a", c1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Put("a", c1); !errors.Is(err, service.ErrUnexpectedTunnel) {
+		t.Fatalf("a tunnel token must be used once, got %v", err)
+	}
+
+	if d, idle := p.Deficit(), p.Idle(); d != 0 || idle != 1 {
+		t.Fatalf("expected deficit 0 and 1 idle, got %d and %d", d, idle)
+	}
+
+	conn, err := p.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conn != c1 {
+		t.Fatal("expected the idle tunnel")
+	}
+
+	// the pool asks for one more tunnel to replace the acquired one
+	if d := p.Deficit(); d != 1 {
+		t.Fatalf("expected deficit 1, got %d", d)
+	}
+}
+
+func TestPool_ExpectExpired(t *testing.T) {
+	p := service.NewPool(1)
+	defer p.Close()
+
+	<-p.Wakeup()
+
+	p.Expect("a", time.Now().Add(time.Millisecond*10))
+
+	select {
+	case <-p.Wakeup():
+	case <-time.After(time.Second):
+		t.Fatal("expected wakeup when the tunnel token expired")
+	}
+
+	if d := p.Deficit(); d != 1 {
+		t.Fatalf("expected deficit 1, got %d", d)
+	}
+
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+
+	if err := p.Put("a", c1); !errors.Is(err, service.ErrUnexpectedTunnel) {
+		t.Fatalf("expected expired tunnel token, got %v", err)
+	}
+}
+
+func TestPool_AcquireWait(t *testing.T) {
+	p := service.NewPool(0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	if _, err := p.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	c1, c2 := net.Pipe()
+	defer c2.Close()
+
+	go func() {
+		for p.Deficit() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		p.Expect("a", time.Now().Add(time.Minute))
+		_ = p.Put("a", c1)
+	}()
+
+	conn, err := p.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conn != c1 {
+		t.Fatal("expected the connected tunnel")
+	}
+
+	go func() {
+		time.Sleep(time.Millisecond * 10)
+		_ = p.Close()
+	}()
+
+	if _, err := p.Acquire(context.Background()); !errors.Is(err, service.ErrPoolClosed) {
+		t.Fatalf("expected pool closed, got %v", err)
+	}
+}
+
+// stream one side of an in-memory ConnectTunnel stream
+type stream struct {
+	ctx  context.Context
+	send chan<- *v1.TunnelMessage
+	recv <-chan *v1.TunnelMessage
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) Send(msg *v1.TunnelMessage) error {
+	select {
+	case s.send <- msg:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *stream) Recv() (*v1.TunnelMessage, error) {
+	select {
+	case msg := <-s.recv:
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func TestPool_Session(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opts := tunnel.NewOptions()
+	opts.SetDefaults()
+
+	a, b := make(chan *v1.TunnelMessage, 16), make(chan *v1.TunnelMessage, 16)
+	session := tunnel.NewServerSession(&stream{ctx: ctx, send: a, recv: b}, opts, "session")
+
+	p := service.NewPool(1)
+	defer p.Close()
+
+	// the caller waiting for a tunnel gets a stream of the session
+	acquired := make(chan net.Conn)
+	go func() {
+		conn, err := p.Acquire(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		acquired <- conn
+	}()
+
+	for p.Deficit() != 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	p.Expect("a", time.Now().Add(time.Minute))
+
+	if err := p.PutSession("a", session); err != nil {
+		t.Fatal(err)
+	}
+
+	if conn := <-acquired; conn == nil {
+		t.Fatal("expected a stream of the session")
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := p.Acquire(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if d, n := p.Deficit(), session.NumStreams(); d != 0 || n != 4 {
+		t.Fatalf("expected deficit 0 and 4 streams, got %d and %d", d, n)
+	}
+
+	p.RemoveSession(session)
+
+	if d := p.Deficit(); d != 1 {
+		t.Fatalf("expected deficit 1 once the session is removed, got %d", d)
+	}
+}
+
+// trackedConn a tunnel which tells when it is closed
+type trackedConn struct {
+	net.Conn
+	done chan struct{}
+}
+
+func (c *trackedConn) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *trackedConn) Close() error {
+	select {
+	case <-c.done:
+	default:
+		close(c.done)
+	}
+	return c.Conn.Close()
+}
+
+// fill connects the tunnels requested by p
+func fill(t *testing.T, p *service.Pool, prefix string) {
+	for i := p.Deficit(); i > 0; i-- {
+		traceId := prefix + strconv.Itoa(i)
+		p.Expect(traceId, time.Now().Add(time.Minute))
+
+		c1, _ := net.Pipe()
+		if err := p.Put(traceId, &trackedConn{Conn: c1, done: make(chan struct{})}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestPool_Scale(t *testing.T) {
+	p := service.NewScalingPool(4, 2, 16)
+	defer p.Close()
+
+	fill(t, p, "a")
+
+	// the size grows as soon as three quarters of it are in use
+	acquired := make([]net.Conn, 0)
+	for i := 0; i < 3; i++ {
+		conn, err := p.Acquire(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		acquired = append(acquired, conn)
+	}
+
+	stats := p.Stats()
+	if stats.Size != 8 || stats.Active != 3 || stats.ScaleUps != 1 || stats.Acquired != 3 {
+		t.Fatalf("expected the pool grown to 8 with 3 tunnels in use, got %+v", stats)
+	}
+
+	fill(t, p, "b")
+
+	for i := 0; i < 6; i++ {
+		conn, err := p.Acquire(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		acquired = append(acquired, conn)
+	}
+
+	if stats := p.Stats(); stats.Size != 16 || stats.Active != 9 || stats.PeakActive != 9 {
+		t.Fatalf("expected the pool grown to its max size 16, got %+v", stats)
+	}
+
+	fill(t, p, "c")
+
+	// the pool is not scaled down while it is busy
+	if p.Scale() {
+		t.Fatal("expected the pool kept while 9 tunnels are in use")
+	}
+
+	for _, conn := range acquired {
+		_ = conn.Close()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for p.Stats().Active > 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	// the peak of the previous period still counts
+	if p.Scale() {
+		t.Fatal("expected the pool kept until a quiet period elapsed")
+	}
+
+	if !p.Scale() {
+		t.Fatal("expected the pool scaled down once it was quiet")
+	}
+
+	if stats := p.Stats(); stats.Size != 8 || stats.Idle != 8 || stats.ScaleDowns != 1 {
+		t.Fatalf("expected the pool shrunk to 8 idle tunnels, got %+v", stats)
+	}
+
+	for p.Scale() {
+	}
+
+	if stats := p.Stats(); stats.Size != 2 || stats.Idle != 2 || p.Deficit() != 0 {
+		t.Fatalf("expected the pool shrunk to its min size 2, got %+v", stats)
+	}
+}
+
+func TestPool_Fixed(t *testing.T) {
+	p := service.NewPool(2)
+	defer p.Close()
+
+	fill(t, p, "a")
+
+	for i := 0; i < 2; i++ {
+		if _, err := p.Acquire(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if stats := p.Stats(); stats.Size != 2 || stats.Active != 2 || p.Scale() {
+		t.Fatalf("expected the size of the pool fixed, got %+v", stats)
+	}
+}
+
+func TestPool_HoldRelease(t *testing.T) {
+	p := service.NewPool(1)
+	defer p.Close()
+
+	fill(t, p, "a")
+
+	conn, err := p.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats := p.Stats(); stats.Active != 1 {
+		t.Fatalf("expected the acquired tunnel to be active, got %d", stats.Active)
+	}
+
+	// a tunnel parked by its user is not active until it is held again
+	p.Release(conn)
+	if stats := p.Stats(); stats.Active != 0 {
+		t.Fatalf("expected the released tunnel to be inactive, got %d", stats.Active)
+	}
+
+	p.Hold(conn)
+	p.Hold(conn)
+	p.Release(conn)
+	if stats := p.Stats(); stats.Active != 1 {
+		t.Fatalf("expected the held tunnel to be active, got %d", stats.Active)
+	}
+
+	_ = conn.Close()
+
+	deadline := time.Now().Add(time.Second)
+	for p.Stats().Active > 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if stats := p.Stats(); stats.Active != 0 {
+		t.Fatalf("expected the closed tunnel to be inactive, got %d", stats.Active)
+	}
+}
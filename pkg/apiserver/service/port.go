@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+)
+
+var (
+	// ErrPortOutOfRange the requested port is not in the range of the allocator
+	ErrPortOutOfRange = errors.New("port is out of range")
+
+	// ErrPortInUse the requested port is used by another upstream
+	ErrPortInUse = errors.New("port is in use")
+
+	// ErrPortExhausted every port of the range is in use
+	ErrPortExhausted = errors.New("no port available")
+)
+
+// PortAllocator hands out the public ports of a range to the upstreams
+type PortAllocator struct {
+	mu   sync.Mutex
+	host string
+	min  int
+	max  int
+	used map[int]struct{}
+}
+
+// Listen listens for tcp connections on port, or on a free port of the
+// range if port is 0. The port is released when the listener is closed.
+func (a *PortAllocator) Listen(port int) (net.Listener, error) {
+	var ln net.Listener
+
+	port, err := a.allocate(port, func(addr string) (err error) {
+		ln, err = net.Listen("tcp", addr)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &portListener{Listener: ln, release: func() { a.release(port) }}, nil
+}
+
+// ListenPacket listens for udp datagrams on port, or on a free port of the
+// range if port is 0. The port is released when the packet conn is closed.
+func (a *PortAllocator) ListenPacket(port int) (net.PacketConn, error) {
+	var pc net.PacketConn
+
+	port, err := a.allocate(port, func(addr string) (err error) {
+		pc, err = net.ListenPacket("udp", addr)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &portPacketConn{PacketConn: pc, release: func() { a.release(port) }}, nil
+}
+
+// allocate reserves port, or a free port of the range if port is 0, that
+// bind succeeds on
+func (a *PortAllocator) allocate(port int, bind func(addr string) error) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if port != 0 {
+		if port < a.min || port > a.max {
+			return 0, fmt.Errorf("%w: %d not in %d-%d", ErrPortOutOfRange, port, a.min, a.max)
+		}
+
+		if _, ok := a.used[port]; ok {
+			return 0, fmt.Errorf("%w: %d", ErrPortInUse, port)
+		}
+
+		if err := bind(net.JoinHostPort(a.host, strconv.Itoa(port))); err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrPortInUse, err)
+		}
+
+		a.used[port] = struct{}{}
+		return port, nil
+	}
+
+	// start from a random port so that released ports are not reused at once
+	size := a.max - a.min + 1
+	offset := rand.Intn(size)
+
+	for i := 0; i < size; i++ {
+		port := a.min + (offset+i)%size
+		if _, ok := a.used[port]; ok {
+			continue
+		}
+
+		if err := bind(net.JoinHostPort(a.host, strconv.Itoa(port))); err != nil {
+			// used by another process
+			continue
+		}
+
+		a.used[port] = struct{}{}
+		return port, nil
+	}
+	return 0, ErrPortExhausted
+}
+
+// release makes port available again
+func (a *PortAllocator) release(port int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.used, port)
+}
+
+// NewPortAllocator create PortAllocator binding the ports min to max on host
+func NewPortAllocator(host string, min, max int) *PortAllocator {
+	return &PortAllocator{host: host, min: min, max: max, used: make(map[int]struct{})}
+}
+
+// portListener releases its port when closed
+type portListener struct {
+	net.Listener
+	once    sync.Once
+	release func()
+}
+
+// Close implements net.Listener
+func (l *portListener) Close() error {
+	err := l.Listener.Close()
+	l.once.Do(l.release)
+	return err
+}
+
+// portPacketConn releases its port when closed
+type portPacketConn struct {
+	net.PacketConn
+	once    sync.Once
+	release func()
+}
+
+// Close implements net.PacketConn
+func (c *portPacketConn) Close() error {
+	err := c.PacketConn.Close()
+	c.once.Do(c.release)
+	return err
+}
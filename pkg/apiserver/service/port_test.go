@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"net"
+	"strconv"
+	"testing"
+)
+
+// freePorts returns the first port of n consecutive ports that look free
+func freePorts(t *testing.T, n int) int {
+	for base := 40000; base < 60000; base += n {
+		ok := true
+		for port := base; port < base+n && ok; port++ {
+			ln, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+			if err != nil {
+				ok = false
+				continue
+			}
+			_ = ln.Close()
+		}
+		if ok {
+			return base
+		}
+	}
+	t.Fatal("no free ports")
+	return 0
+}
+
+func TestPortAllocator(t *testing.T) {
+	base := freePorts(t, 2)
+	a := service.NewPortAllocator("127.0.0.1", base, base+1)
+
+	if _, err := a.Listen(base + 2); !errors.Is(err, service.ErrPortOutOfRange) {
+		t.Fatalf("expected ErrPortOutOfRange, got %v", err)
+	}
+
+	ln1, err := a.Listen(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.Listen(base); !errors.Is(err, service.ErrPortInUse) {
+		t.Fatalf("expected ErrPortInUse, got %v", err)
+	}
+
+	ln2, err := a.Listen(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if port := ln2.Addr().(*net.TCPAddr).Port; port != base+1 {
+		t.Fatalf("expected the free port %d allocated, got %d", base+1, port)
+	}
+
+	if _, err := a.Listen(0); !errors.Is(err, service.ErrPortExhausted) {
+		t.Fatalf("expected ErrPortExhausted, got %v", err)
+	}
+
+	_ = ln1.Close()
+	defer ln2.Close()
+
+	ln3, err := a.Listen(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln3.Close()
+
+	if port := ln3.Addr().(*net.TCPAddr).Port; port != base {
+		t.Fatalf("expected the released port %d allocated, got %d", base, port)
+	}
+}
+
+func TestPortAllocator_ListenPacket(t *testing.T) {
+	base := freePorts(t, 1)
+	a := service.NewPortAllocator("127.0.0.1", base, base)
+
+	pc, err := a.ListenPacket(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if port := pc.LocalAddr().(*net.UDPAddr).Port; port != base {
+		t.Fatalf("expected the port %d allocated, got %d", base, port)
+	}
+
+	if _, err := a.ListenPacket(0); !errors.Is(err, service.ErrPortExhausted) {
+		t.Fatalf("expected ErrPortExhausted, got %v", err)
+	}
+
+	_ = pc.Close()
+
+	pc, err = a.ListenPacket(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = pc.Close()
+}
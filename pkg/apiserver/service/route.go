@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"strings"
+)
+
+// routes the upstream groups of a hostname, one for every path prefix,
+// sorted by the length of the prefix, the longest first. The group
+// without prefix serves the paths no other prefix matches.
+type routes []*upstreamGroup
+
+// match returns the group of the longest prefix matching path, nil if none
+func (rs routes) match(path string) *upstreamGroup {
+	for _, g := range rs {
+		if MatchPathPrefix(path, g.prefix) {
+			return g
+		}
+	}
+	return nil
+}
+
+// find returns the group of prefix, nil if none
+func (rs routes) find(prefix string) *upstreamGroup {
+	for _, g := range rs {
+		if g.prefix == prefix {
+			return g
+		}
+	}
+	return nil
+}
+
+// members returns the upstreams of every group of rs
+func (rs routes) members() []*Upstream {
+	var members []*Upstream
+	for _, g := range rs {
+		members = append(members, g.members...)
+	}
+	return members
+}
+
+// add returns rs with g inserted before the groups of a shorter prefix
+func (rs routes) add(g *upstreamGroup) routes {
+	i := 0
+	for i < len(rs) && len(rs[i].prefix) >= len(g.prefix) {
+		i++
+	}
+
+	rs = append(rs, nil)
+	copy(rs[i+1:], rs[i:])
+	rs[i] = g
+	return rs
+}
+
+// remove returns rs without g
+func (rs routes) remove(g *upstreamGroup) routes {
+	for i, r := range rs {
+		if r == g {
+			return append(rs[:i], rs[i+1:]...)
+		}
+	}
+	return rs
+}
+
+// MatchPathPrefix reports whether prefix matches whole segments of path,
+// /v1 matches /v1 and /v1/users but not /v10. The empty prefix matches
+// every path.
+func MatchPathPrefix(path, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	return len(path) == len(prefix) || path[len(prefix)] == '/'
+}
+
+// NormalizePathPrefix strip the trailing slash of prefix, the prefix / is empty
+func NormalizePathPrefix(prefix string) string {
+	return strings.TrimRight(prefix, "/")
+}
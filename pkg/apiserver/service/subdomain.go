@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+var (
+	// adjectives the first word of the random subdomains
+	adjectives = []string{
+		"amber", "bold", "brave", "bright", "calm", "clever", "cosmic", "crisp",
+		"eager", "fancy", "gentle", "golden", "happy", "jolly", "keen", "lively",
+		"lucky", "mellow", "misty", "noble", "proud", "quick", "quiet", "rapid",
+		"shiny", "silent", "snowy", "sunny", "swift", "tidy", "vivid", "witty",
+	}
+
+	// nouns the second word of the random subdomains
+	nouns = []string{
+		"badger", "bison", "cedar", "comet", "coral", "crane", "delta", "falcon",
+		"fjord", "gecko", "harbor", "heron", "island", "koala", "lagoon", "lynx",
+		"maple", "meadow", "nebula", "otter", "panda", "pebble", "quartz", "raven",
+		"river", "sparrow", "summit", "tiger", "tundra", "walrus", "willow", "zebra",
+	}
+)
+
+// maxSubdomainAttempts the random subdomains drawn before giving up on a
+// base domain whose subdomains are all taken
+const maxSubdomainAttempts = 16
+
+// randomSubdomain returns a random human readable label such as brave-otter-4821
+func randomSubdomain(r *rand.Rand) string {
+	return fmt.Sprintf("%s-%s-%04d", adjectives[r.Intn(len(adjectives))], nouns[r.Intn(len(nouns))], r.Intn(10000))
+}
+
+// wildcardOf returns the wildcard hostname serving hostname, the parent
+// domain of hostname prefixed by `*.`, empty if hostname has no parent
+func wildcardOf(hostname string) string {
+	if strings.HasPrefix(hostname, "*.") {
+		return ""
+	}
+
+	i := strings.IndexByte(hostname, '.')
+	if i <= 0 {
+		return ""
+	}
+	return "*" + hostname[i:]
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/golang-jwt/jwt/v4"
+	"time"
+)
+
+const (
+	// AudienceSession audience of the tokens returned by login
+	AudienceSession = "session"
+
+	// AudienceTunnel audience of the one-time tokens used to connect tunnels
+	AudienceTunnel = "tunnel"
+)
+
+// ErrInvalidToken the token is malformed, expired or not signed by us
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims the claims carried by the tokens signed by the server,
+// the subject is always the access key id of the token owner
+type Claims struct {
+	jwt.RegisteredClaims
+
+	// Hostname the hostname of the upstream a tunnel token belongs to
+	Hostname string `json:"hostname,omitempty"`
+
+	// Upstream the id of the upstream a tunnel token belongs to
+	Upstream string `json:"upstream,omitempty"`
+}
+
+// TokenService signs and verifies the json web tokens of the server
+type TokenService struct {
+	issuer          string
+	keys            [][]byte
+	expiresIn       time.Duration
+	tunnelExpiresIn time.Duration
+}
+
+// IssueSessionToken sign a new session token for the owner of accessKeyId
+func (s *TokenService) IssueSessionToken(accessKeyId string) (string, time.Time, error) {
+	return s.issue(AudienceSession, accessKeyId, "", s.expiresIn, &Claims{})
+}
+
+// ParseSessionToken verify a session token and return its claims
+func (s *TokenService) ParseSessionToken(token string) (*Claims, error) {
+	claims := &Claims{}
+	if err := s.parse(token, AudienceSession, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// IssueTunnelToken sign a one-time token which allows the owner of accessKeyId
+// to connect a tunnel of the upstream upstreamId of hostname, traceId is used
+// as the token id
+func (s *TokenService) IssueTunnelToken(accessKeyId, hostname, upstreamId, traceId string) (string, time.Time, error) {
+	return s.issue(AudienceTunnel, accessKeyId, traceId, s.tunnelExpiresIn,
+		&Claims{Hostname: hostname, Upstream: upstreamId})
+}
+
+// ParseTunnelToken verify a tunnel token and return its claims
+func (s *TokenService) ParseTunnelToken(token string) (*Claims, error) {
+	claims := &Claims{}
+	if err := s.parse(token, AudienceTunnel, claims); err != nil {
+		return nil, err
+	}
+
+	if claims.ID == "" || claims.Hostname == "" || claims.Upstream == "" {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// issue fill the registered claims of claims and sign them
+func (s *TokenService) issue(audience, subject, id string, expiresIn time.Duration, claims *Claims) (string, time.Time, error) {
+	now := time.Now()
+	expiredAt := now.Add(expiresIn)
+
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        id,
+		Issuer:    s.issuer,
+		Subject:   subject,
+		Audience:  jwt.ClaimStrings{audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiredAt),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.keys[0])
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable sign token, got: %v", err)
+	}
+	return token, expiredAt, nil
+}
+
+// parse verify token with every signing key and check the registered claims
+func (s *TokenService) parse(token, audience string, claims *Claims) error {
+	var (
+		err    error
+		parser = jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}))
+	)
+
+	for _, key := range s.keys {
+		if _, err = parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+			return key, nil
+		}); err == nil {
+			break
+		}
+
+		// try the next key only when the signature does not match this one
+		var e *jwt.ValidationError
+		if !errors.As(err, &e) || e.Errors != jwt.ValidationErrorSignatureInvalid {
+			break
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if !claims.VerifyAudience(audience, true) || !claims.VerifyIssuer(s.issuer, true) {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// NewTokenService create TokenService via config.TokenOptions
+func NewTokenService(opts *config.TokenOptions) (*TokenService, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(opts.SigningKeys))
+	for _, key := range opts.SigningKeys {
+		keys = append(keys, []byte(key))
+	}
+
+	return &TokenService{
+		keys:            keys,
+		issuer:          opts.Issuer,
+		expiresIn:       time.Duration(opts.ExpiresIn),
+		tunnelExpiresIn: time.Duration(opts.TunnelExpiresIn),
+	}, nil
+}
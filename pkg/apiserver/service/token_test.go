@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"errors"
+	"github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/types"
+	"testing"
+	"time"
+)
+
+const (
+	oldKey = "0123456789abcdef0123456789abcdef"
+	newKey = "fedcba9876543210fedcba9876543210"
+)
+
+func newTokenService(t *testing.T, keys ...string) *service.TokenService {
+	opts := &config.TokenOptions{}
+	opts.SetDefaults()
+	opts.SigningKeys = keys
+
+	s, err := service.NewTokenService(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestTokenService_SessionToken(t *testing.T) {
+	s := newTokenService(t, newKey)
+
+	token, expiredAt, err := s.IssueSessionToken("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d := time.Until(expiredAt); d <= 23*time.Hour || d > 24*time.Hour {
+		t.Fatalf("unexpected expiration %s", expiredAt)
+	}
+
+	claims, err := s.ParseSessionToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claims.Subject != "admin" {
+		t.Fatalf("unexpected subject %s", claims.Subject)
+	}
+
+	if _, err := s.ParseSessionToken(token + "x"); !errors.Is(err, service.ErrInvalidToken) {
+		t.Fatalf("expected invalid token, got %v", err)
+	}
+}
+
+func TestTokenService_RotateKeys(t *testing.T) {
+	token, _, err := newTokenService(t, oldKey).IssueSessionToken("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := newTokenService(t, newKey, oldKey).ParseSessionToken(token); err != nil {
+		t.Fatalf("token signed by a retired key should be valid, got %v", err)
+	}
+
+	if _, err := newTokenService(t, newKey).ParseSessionToken(token); !errors.Is(err, service.ErrInvalidToken) {
+		t.Fatalf("token signed by a removed key should be invalid, got %v", err)
+	}
+}
+
+func TestTokenService_Expired(t *testing.T) {
+	opts := &config.TokenOptions{}
+	opts.SetDefaults()
+	opts.SigningKeys = []string{newKey}
+	opts.ExpiresIn = types.Duration(time.Nanosecond)
+
+	s, err := service.NewTokenService(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, _, err := s.IssueSessionToken("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Second)
+
+	if _, err := s.ParseSessionToken(token); !errors.Is(err, service.ErrInvalidToken) {
+		t.Fatalf("expected expired token, got %v", err)
+	}
+}
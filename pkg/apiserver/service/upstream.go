@@ -0,0 +1,778 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/model"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUpstreamExists the hostname is already watched by another client
+	ErrUpstreamExists = errors.New("hostname is already watched by another client")
+
+	// ErrUpstreamNotFound no client is watching the hostname
+	ErrUpstreamNotFound = errors.New("upstream not found")
+
+	// ErrProtocolUnsupported the protocol of the upstream is disabled on the server
+	ErrProtocolUnsupported = errors.New("protocol is not supported")
+
+	// ErrInvalidPoolSize the min pool size is greater than the max pool size
+	ErrInvalidPoolSize = errors.New("min pool size is greater than max pool size")
+
+	// ErrUpstreamUnhealthy the local services of every upstream of the hostname fail their health check
+	ErrUpstreamUnhealthy = errors.New("upstream is unhealthy")
+
+	// ErrInvalidRoute the path prefix or its rewrite cannot be served
+	ErrInvalidRoute = errors.New("invalid route")
+
+	// ErrHostnameRequired no hostname is watched and the server has no base
+	// domain to allocate a subdomain of
+	ErrHostnameRequired = errors.New("hostname is required")
+)
+
+// scaleInterval the pool of an upstream is considered for scaling down this often
+const scaleInterval = time.Second * 30
+
+// maxPoolSize the largest pool a client may request, the validation rules of
+// WatchTunnelsRequest enforce it too
+const maxPoolSize = 1024
+
+// Upstream a hostname watched by a client and the pool of its tunnels
+type Upstream struct {
+	*model.Upstream
+
+	pool       *Pool
+	listener   net.Listener
+	packetConn net.PacketConn
+
+	requestHeaders  headerRules
+	responseHeaders headerRules
+	policy          accessPolicy
+	credentials     credentials
+
+	// watch the generation of the watch serving the upstream, 0 while the
+	// upstream waits to be resumed, guarded by the mutex of UpstreamService
+	watch  uint64
+	cancel context.CancelFunc
+	expiry *time.Timer
+
+	// currentWeight the state of the weighted balancing of its hostname,
+	// guarded by the mutex of UpstreamService
+	currentWeight int
+}
+
+// Pool returns the tunnel pool of the upstream
+func (u *Upstream) Pool() *Pool {
+	return u.pool
+}
+
+// Listener returns the listener of the public port of a TCP upstream
+func (u *Upstream) Listener() net.Listener {
+	return u.listener
+}
+
+// PacketConn returns the packet conn of the public port of a UDP upstream
+func (u *Upstream) PacketConn() net.PacketConn {
+	return u.packetConn
+}
+
+// UpstreamService keeps track of the upstreams watched by the clients
+// connected to the current node. The upstream of a dropped watch is kept
+// for the resume timeout, so that its client reconnects without losing
+// the hostname, the port and the tunnels still alive. A hostname watched
+// with a load balancing policy is served by every client of its owner
+// watching it with the same policy. The paths of an HTTP hostname may be
+// routed to the clients by their prefix. A wildcard hostname serves the
+// subdomains one level below its parent domain which are not registered by
+// themselves, the clients watching no hostname are allocated a random
+// subdomain of the base domain.
+type UpstreamService struct {
+	mu            sync.RWMutex
+	tokens        *TokenService
+	tcpPorts      *PortAllocator
+	udpPorts      *PortAllocator
+	resumeTimeout time.Duration
+	baseDomain    string
+	rand          *rand.Rand
+	generation    uint64
+	upstreams     map[string]routes
+	observers     []func(*Upstream)
+}
+
+// OnRegister calls fn in its own goroutine whenever a hostname is
+// registered, with its first upstream
+func (s *UpstreamService) OnRegister(fn func(*Upstream)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.observers = append(s.observers, fn)
+}
+
+// resolve returns the routes serving hostname, those of its wildcard
+// hostname if it is not registered by itself, must be called with s.mu held
+func (s *UpstreamService) resolve(hostname string) routes {
+	hostname = NormalizeHostname(hostname)
+
+	if rs, ok := s.upstreams[hostname]; ok {
+		return rs
+	}
+
+	if wildcard := wildcardOf(hostname); wildcard != "" {
+		return s.upstreams[wildcard]
+	}
+	return nil
+}
+
+// Get returns the first upstream of the route of hostname with the
+// shortest path prefix, the upstreams of a hostname share its owner,
+// protocol and public port
+func (s *UpstreamService) Get(hostname string) (*Upstream, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rs := s.resolve(hostname)
+	if len(rs) == 0 {
+		return nil, ErrUpstreamNotFound
+	}
+	return rs[len(rs)-1].members[0], nil
+}
+
+// Match returns the first upstream of the route of hostname serving path,
+// the one with the longest path prefix matching it
+func (s *UpstreamService) Match(hostname, path string) (*Upstream, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	g := s.resolve(hostname).match(path)
+	if g == nil {
+		return nil, ErrUpstreamNotFound
+	}
+	return g.members[0], nil
+}
+
+// Lookup returns the upstream id of hostname
+func (s *UpstreamService) Lookup(hostname, id string) (*Upstream, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, g := range s.resolve(hostname) {
+		for _, u := range g.members {
+			if strings.EqualFold(u.ID, id) {
+				return u, nil
+			}
+		}
+	}
+	return nil, ErrUpstreamNotFound
+}
+
+// List returns the upstreams of every route of hostname
+func (s *UpstreamService) List(hostname string) []*Upstream {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.resolve(hostname).members()
+}
+
+// Pick chooses the upstream the next tunnel is taken from out of the
+// healthy ones of the route of hostname serving path, by the load
+// balancing policy of the route
+func (s *UpstreamService) Pick(hostname, path string) (*Upstream, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g := s.resolve(hostname).match(path)
+	if g == nil {
+		return nil, ErrUpstreamNotFound
+	}
+
+	u := g.pick()
+	if u == nil {
+		return nil, ErrUpstreamUnhealthy
+	}
+	return u, nil
+}
+
+// Healthy reports whether the route of hostname serving path has an
+// upstream which is not unhealthy, ErrUpstreamNotFound if there is none
+func (s *UpstreamService) Healthy(hostname, path string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	g := s.resolve(hostname).match(path)
+	if g == nil {
+		return false, ErrUpstreamNotFound
+	}
+
+	for _, u := range g.members {
+		if u.Status != model.UpstreamStatusUnhealthy {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// SetHealthy marks the upstream id of hostname registered by accessKeyId
+// healthy or not, no tunnel is taken from an unhealthy upstream. It returns
+// the status of the upstream and whether it changed.
+func (s *UpstreamService) SetHealthy(accessKeyId, hostname, id string, healthy bool) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.resolve(hostname).members() {
+		if !strings.EqualFold(u.ID, id) || u.AccessKeyId != accessKeyId {
+			continue
+		}
+
+		status := model.UpstreamStatusActive
+		if !healthy {
+			status = model.UpstreamStatusUnhealthy
+		}
+
+		if u.Status == status {
+			return status, false, nil
+		}
+
+		u.Status, u.UpdatedAt = status, time.Now()
+		return status, true, nil
+	}
+	return "", false, ErrUpstreamNotFound
+}
+
+// Watch registers the upstream of request for the owner of accessKeyId, or
+// resumes the upstream of request.ResumeId, and sends a one-time tunnel
+// token whenever its pool needs one more tunnel, until ctx is done, send
+// fails or another watch resumes the upstream. The upstream is removed on
+// return unless it is resumed within the resume timeout. The window size of
+// request must be negotiated by the caller already.
+func (s *UpstreamService) Watch(ctx context.Context, accessKeyId string,
+	request *v1.WatchTunnelsRequest, send func(*v1.WatchTunnelsResponse) error) error {
+	l := log.FromContext(ctx).Sugar()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	u, watch, resumed, err := s.register(accessKeyId, request, cancel)
+	if err != nil {
+		return err
+	}
+
+	defer s.release(u, watch)
+
+	if resumed {
+		l.Infof("%s upstream %s of %s%s is resumed by %s", u.Protocol, u.ID, u.DomainName, u.PathPrefix, accessKeyId)
+	} else {
+		l.Infof("%s upstream %s of %s%s is watched by %s with pool size %d",
+			u.Protocol, u.ID, u.DomainName, u.PathPrefix, accessKeyId, request.PoolSize)
+	}
+
+	if u.listener != nil {
+		l.Infof("upstream %s of %s listens at %s", u.ID, u.DomainName, u.listener.Addr())
+	}
+
+	if u.packetConn != nil {
+		l.Infof("upstream %s of %s listens at udp %s", u.ID, u.DomainName, u.packetConn.LocalAddr())
+	}
+
+	ticker := time.NewTicker(scaleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			l.Infof("upstream %s of %s is no longer watched", u.ID, u.DomainName)
+			return nil
+		case <-ticker.C:
+			if u.pool.Scale() {
+				stats := u.pool.Stats()
+				l.Infof("pool of %s scaled down to %d, %d tunnels in use at most", u.DomainName,
+					stats.Size, stats.PeakActive)
+			}
+			continue
+		case <-u.pool.Wakeup():
+		}
+
+		for i := u.pool.Deficit(); i > 0; i-- {
+			traceId := uuid.New().String()
+
+			token, expiredAt, err := s.tokens.IssueTunnelToken(accessKeyId, u.DomainName, u.ID, traceId)
+			if err != nil {
+				return err
+			}
+
+			u.pool.Expect(traceId, expiredAt)
+
+			response := &v1.WatchTunnelsResponse{
+				TraceId:     traceId,
+				TunnelToken: token,
+				Port:        u.Port,
+				Compression: u.Compression,
+				UpstreamId:  u.ID,
+				Hostname:    u.DomainName,
+				WindowSize:  u.WindowSize,
+			}
+
+			if err := send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// register adds the upstream of request served by the watch which cancel
+// stops. A route, the hostname and path prefix, is owned by one client,
+// unless it is watched with a load balancing policy, then the other clients
+// of its owner may join with the same policy, protocol, http2 and rewrite.
+// The routes of a hostname share its owner and protocol. The upstream of
+// request.ResumeId is resumed instead if it was registered by accessKeyId
+// with the same protocol. It returns the generation of the watch and
+// whether the upstream is resumed.
+func (s *UpstreamService) register(accessKeyId string,
+	request *v1.WatchTunnelsRequest, cancel context.CancelFunc) (*Upstream, uint64, bool, error) {
+	if err := validateRoute(request); err != nil {
+		return nil, 0, false, err
+	}
+
+	requestHeaders, responseHeaders, err := validateHeaderRules(request)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	policy, allowCIDRs, denyCIDRs, err := compileAccessPolicy(request.AllowCidrs, request.DenyCidrs)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	credentials, err := validateCredentials(request)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hostname := NormalizeHostname(request.Hostname)
+	prefix := NormalizePathPrefix(request.PathPrefix)
+	rewrite := NormalizePathPrefix(request.RewritePrefix)
+
+	s.generation++
+
+	if hostname == "" {
+		if hostname, err = s.allocateLocked(accessKeyId, request.ResumeId); err != nil {
+			return nil, 0, false, err
+		}
+	}
+
+	rs := s.upstreams[hostname]
+
+	if len(rs) > 0 {
+		if first := rs[0].members[0]; first.AccessKeyId != accessKeyId || first.Protocol != request.Protocol {
+			return nil, 0, false, ErrUpstreamExists
+		}
+	}
+
+	g := rs.find(prefix)
+	exists := g != nil
+	if exists {
+		if u := s.resumable(g, accessKeyId, request); u != nil {
+			// the previous watch may not have noticed that its client is gone
+			if u.cancel != nil {
+				u.cancel()
+			}
+
+			if u.expiry != nil {
+				u.expiry.Stop()
+				u.expiry = nil
+			}
+
+			u.watch, u.cancel = s.generation, cancel
+			u.UpdatedAt = time.Now()
+
+			// the tunnels requested by the previous watch will never be connected
+			u.pool.Forget()
+			return u, u.watch, true, nil
+		}
+
+		first := g.members[0]
+		if g.policy == "" || request.LoadBalancing != g.policy || first.AccessKeyId != accessKeyId ||
+			first.Protocol != request.Protocol || first.HTTP2 != isHTTP2(request) ||
+			first.StripPrefix != request.StripPrefix || first.RewritePrefix != rewrite ||
+			!slices.Equal(first.AllowCIDRs, allowCIDRs) || !slices.Equal(first.DenyCIDRs, denyCIDRs) ||
+			!first.credentials.equal(&credentials) ||
+			(request.Port != 0 && request.Port != first.Port) {
+			return nil, 0, false, ErrUpstreamExists
+		}
+	}
+
+	if request.MaxPoolSize > 0 && request.MinPoolSize > request.MaxPoolSize {
+		return nil, 0, false, ErrInvalidPoolSize
+	}
+
+	if !exists {
+		g = &upstreamGroup{prefix: prefix, policy: request.LoadBalancing}
+
+		switch request.Protocol {
+		case model.ProtocolTCP:
+			if s.tcpPorts == nil {
+				return nil, 0, false, fmt.Errorf("%w: %s", ErrProtocolUnsupported, request.Protocol)
+			}
+
+			ln, err := s.tcpPorts.Listen(int(request.Port))
+			if err != nil {
+				return nil, 0, false, err
+			}
+			g.listener = ln
+		case model.ProtocolUDP:
+			if s.udpPorts == nil {
+				return nil, 0, false, fmt.Errorf("%w: %s", ErrProtocolUnsupported, request.Protocol)
+			}
+
+			pc, err := s.udpPorts.ListenPacket(int(request.Port))
+			if err != nil {
+				return nil, 0, false, err
+			}
+			g.packetConn = pc
+		}
+	}
+
+	var port int32
+	if g.listener != nil {
+		port = int32(g.listener.Addr().(*net.TCPAddr).Port)
+	}
+
+	if g.packetConn != nil {
+		port = int32(g.packetConn.LocalAddr().(*net.UDPAddr).Port)
+	}
+
+	size := clampPoolSize(request.PoolSize)
+
+	// the port is reported with the tunnel tokens, keep one tunnel
+	// ready so that the client learns it at once. A multiplexed upstream
+	// keeps one session ready to spare the streams the warm-up.
+	if (port != 0 || request.Multiplex) && size < 1 {
+		size = 1
+	}
+
+	// a session carries many streams, the sessions are never scaled
+	minSize, maxSize := clampPoolSize(request.MinPoolSize), clampPoolSize(request.MaxPoolSize)
+	if request.Multiplex {
+		minSize, maxSize = 0, 0
+	}
+
+	// the bytes of a TLS upstream are encrypted, compressing them is useless
+	var compression string
+	if request.Protocol != model.ProtocolTLS {
+		compression = tunnel.NegotiateCodec(request.Compression)
+	}
+
+	weight := request.Weight
+	if weight == 0 {
+		weight = 1
+	}
+
+	now := time.Now()
+
+	u := &Upstream{
+		Upstream: &model.Upstream{
+			ID:              uuid.New().String(),
+			Status:          model.UpstreamStatusActive,
+			DomainName:      hostname,
+			Protocol:        request.Protocol,
+			Port:            port,
+			HTTP2:           isHTTP2(request),
+			Multiplex:       request.Multiplex,
+			Compression:     compression,
+			WindowSize:      request.WindowSize,
+			LoadBalancing:   request.LoadBalancing,
+			Weight:          weight,
+			PathPrefix:      prefix,
+			StripPrefix:     request.StripPrefix,
+			RewritePrefix:   rewrite,
+			RequestHeaders:  requestHeaders.rules,
+			ResponseHeaders: responseHeaders.rules,
+			AllowCIDRs:      allowCIDRs,
+			DenyCIDRs:       denyCIDRs,
+			AccessKeyId:     accessKeyId,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		},
+		pool:            NewScalingPool(size, minSize, maxSize),
+		listener:        g.listener,
+		packetConn:      g.packetConn,
+		requestHeaders:  requestHeaders,
+		responseHeaders: responseHeaders,
+		policy:          policy,
+		credentials:     credentials,
+		watch:           s.generation,
+		cancel:          cancel,
+	}
+
+	g.members = append(g.members, u)
+
+	if exists {
+		return u, u.watch, false, nil
+	}
+
+	s.upstreams[hostname] = rs.add(g)
+
+	if len(rs) > 0 {
+		// the hostname was registered by its other routes
+		return u, u.watch, false, nil
+	}
+
+	for _, fn := range s.observers {
+		go fn(u)
+	}
+	return u, u.watch, false, nil
+}
+
+// clampPoolSize returns size bounded by 0 and maxPoolSize, every missing
+// tunnel of a pool costs a signed token and a timer
+func clampPoolSize(size int32) int {
+	if size < 0 {
+		return 0
+	}
+
+	if size > maxPoolSize {
+		return maxPoolSize
+	}
+	return int(size)
+}
+
+// allocateLocked returns the subdomain of the base domain registered by the
+// upstream resumeId of accessKeyId, a random subdomain which is not
+// registered if none. No subdomain is allocated while the wildcard of the
+// base domain is registered by another access key. It must be called with
+// s.mu held.
+func (s *UpstreamService) allocateLocked(accessKeyId, resumeId string) (string, error) {
+	if s.baseDomain == "" {
+		return "", ErrHostnameRequired
+	}
+
+	suffix := "." + s.baseDomain
+
+	if resumeId != "" {
+		for hostname, rs := range s.upstreams {
+			if !strings.HasSuffix(hostname, suffix) {
+				continue
+			}
+
+			for _, u := range rs.members() {
+				if strings.EqualFold(u.ID, resumeId) && u.AccessKeyId == accessKeyId {
+					return hostname, nil
+				}
+			}
+		}
+	}
+
+	// a random subdomain would shadow the wildcard of another owner
+	if rs := s.upstreams["*"+suffix]; len(rs) > 0 && rs[0].members[0].AccessKeyId != accessKeyId {
+		return "", fmt.Errorf("%w: the subdomains of %s are served by *%s", ErrUpstreamExists, s.baseDomain, suffix)
+	}
+
+	for i := 0; i < maxSubdomainAttempts; i++ {
+		hostname := randomSubdomain(s.rand) + suffix
+		if _, ok := s.upstreams[hostname]; !ok {
+			return hostname, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no subdomain of %s is free", ErrUpstreamExists, s.baseDomain)
+}
+
+// resumable returns the upstream of g request.ResumeId resumes, nil if none
+func (s *UpstreamService) resumable(g *upstreamGroup, accessKeyId string, request *v1.WatchTunnelsRequest) *Upstream {
+	if request.ResumeId == "" {
+		return nil
+	}
+
+	for _, u := range g.members {
+		if strings.EqualFold(request.ResumeId, u.ID) && u.AccessKeyId == accessKeyId && u.Protocol == request.Protocol {
+			return u
+		}
+	}
+	return nil
+}
+
+// release detaches the watch of generation watch from u, u is unregistered
+// unless it is resumed within the resume timeout
+func (s *UpstreamService) release(u *Upstream, watch uint64) {
+	s.mu.Lock()
+
+	if u.watch != watch {
+		// another watch has taken over
+		s.mu.Unlock()
+		return
+	}
+
+	u.watch, u.cancel = 0, nil
+
+	if s.resumeTimeout > 0 {
+		u.expiry = time.AfterFunc(s.resumeTimeout, func() {
+			s.expire(u)
+		})
+		s.mu.Unlock()
+		return
+	}
+
+	last := s.removeLocked(u)
+	s.mu.Unlock()
+
+	s.shutdown(u, last)
+}
+
+// expire unregisters u if it was not resumed
+func (s *UpstreamService) expire(u *Upstream) {
+	s.mu.Lock()
+
+	if u.watch != 0 {
+		s.mu.Unlock()
+		return
+	}
+
+	last := s.removeLocked(u)
+	s.mu.Unlock()
+
+	s.shutdown(u, last)
+}
+
+// removeLocked forgets u, must be called with s.mu held. It reports whether
+// u was the last upstream of its route, which is then unregistered.
+func (s *UpstreamService) removeLocked(u *Upstream) bool {
+	rs := s.upstreams[u.DomainName]
+
+	g := rs.find(u.PathPrefix)
+	if g == nil || !g.remove(u) || len(g.members) > 0 {
+		return false
+	}
+
+	if rs = rs.remove(g); len(rs) == 0 {
+		delete(s.upstreams, u.DomainName)
+	} else {
+		s.upstreams[u.DomainName] = rs
+	}
+	return true
+}
+
+// shutdown closes the pool of the removed upstream u, and releases the port
+// of its hostname if it was the last upstream, a hostname with a port has
+// one route only
+func (s *UpstreamService) shutdown(u *Upstream, last bool) {
+	if last && u.listener != nil {
+		_ = u.listener.Close()
+	}
+
+	if last && u.packetConn != nil {
+		_ = u.packetConn.Close()
+	}
+	_ = u.pool.Close()
+}
+
+// validateRoute verifies that the path prefix and its rewrite of request
+// can be served, only the requests of HTTP and HTTPS are routed by path
+func validateRoute(request *v1.WatchTunnelsRequest) error {
+	if request.PathPrefix == "" && !request.StripPrefix && request.RewritePrefix == "" {
+		return nil
+	}
+
+	if request.Protocol != model.ProtocolHTTP && request.Protocol != model.ProtocolHTTPS {
+		return fmt.Errorf("%w: %s upstreams are not routed by path", ErrInvalidRoute, request.Protocol)
+	}
+
+	if request.StripPrefix && request.RewritePrefix != "" {
+		return fmt.Errorf("%w: the path prefix is either stripped or rewritten", ErrInvalidRoute)
+	}
+	return nil
+}
+
+// validateHeaderRules returns the compiled request and response header rules
+// of request, the headers of HTTP and HTTPS upstreams only are rewritten
+func validateHeaderRules(request *v1.WatchTunnelsRequest) (headerRules, headerRules, error) {
+	if len(request.RequestHeaders) == 0 && len(request.ResponseHeaders) == 0 {
+		return headerRules{}, headerRules{}, nil
+	}
+
+	if request.Protocol != model.ProtocolHTTP && request.Protocol != model.ProtocolHTTPS {
+		return headerRules{}, headerRules{}, fmt.Errorf("%w: the headers of %s upstreams are not rewritten",
+			ErrInvalidHeaderRule, request.Protocol)
+	}
+
+	requestHeaders, err := compileHeaderRules(request.RequestHeaders, true)
+	if err != nil {
+		return headerRules{}, headerRules{}, err
+	}
+
+	responseHeaders, err := compileHeaderRules(request.ResponseHeaders, false)
+	if err != nil {
+		return headerRules{}, headerRules{}, err
+	}
+	return requestHeaders, responseHeaders, nil
+}
+
+// validateCredentials returns the credentials required from the clients of
+// request, the clients of HTTP and HTTPS upstreams only are gated
+func validateCredentials(request *v1.WatchTunnelsRequest) (credentials, error) {
+	if len(request.BasicAuth) == 0 && len(request.BearerTokens) == 0 {
+		return credentials{}, nil
+	}
+
+	if request.Protocol != model.ProtocolHTTP && request.Protocol != model.ProtocolHTTPS {
+		return credentials{}, fmt.Errorf("%w: the clients of %s upstreams are not gated",
+			ErrInvalidCredentials, request.Protocol)
+	}
+	return compileCredentials(request.BasicAuth, request.BearerTokens)
+}
+
+// isHTTP2 reports whether the local service of request is forwarded HTTP/2
+func isHTTP2(request *v1.WatchTunnelsRequest) bool {
+	return request.Http2 && (request.Protocol == model.ProtocolHTTP || request.Protocol == model.ProtocolHTTPS)
+}
+
+// NormalizeHostname lower case hostname and strip its port and trailing dot
+func NormalizeHostname(hostname string) string {
+	if i := strings.LastIndexByte(hostname, ':'); i != -1 && !strings.Contains(hostname[i:], "]") {
+		hostname = hostname[:i]
+	}
+	return strings.ToLower(strings.TrimSuffix(hostname, "."))
+}
+
+// NewUpstreamService create UpstreamService which signs tunnel tokens with
+// tokens and allocates the ports of the TCP and UDP upstreams with tcpPorts
+// and udpPorts, the upstreams of a protocol are refused if its allocator is
+// nil. The upstream of a dropped watch is removed after resumeTimeout, at
+// once if it is 0. The clients watching no hostname are allocated a random
+// subdomain of baseDomain, they are refused if it is empty.
+func NewUpstreamService(tokens *TokenService, tcpPorts, udpPorts *PortAllocator,
+	resumeTimeout time.Duration, baseDomain string) *UpstreamService {
+	return &UpstreamService{
+		tokens:        tokens,
+		tcpPorts:      tcpPorts,
+		udpPorts:      udpPorts,
+		resumeTimeout: resumeTimeout,
+		baseDomain:    NormalizeHostname(baseDomain),
+		rand:          rand.New(rand.NewSource(time.Now().UnixNano())),
+		upstreams:     make(map[string]routes),
+	}
+}
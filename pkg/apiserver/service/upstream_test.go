@@ -0,0 +1,498 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"math"
+	"net"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// watchMember registers request for accessKeyId and returns the id of its
+// upstream, the tunnels requested by its pool are connected
+func watchMember(ctx context.Context, t *testing.T, upstreams *service.UpstreamService, accessKeyId string,
+	request *v1.WatchTunnelsRequest) (string, error) {
+	ids := make(chan string, 1)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- upstreams.Watch(ctx, accessKeyId, request, func(resp *v1.WatchTunnelsResponse) error {
+			u, err := upstreams.Lookup(resp.Hostname, resp.UpstreamId)
+			if err != nil {
+				return err
+			}
+
+			c1, _ := net.Pipe()
+			if err := u.Pool().Put(resp.TraceId, &trackedConn{Conn: c1, done: make(chan struct{})}); err != nil {
+				return err
+			}
+
+			select {
+			case ids <- resp.UpstreamId:
+			default:
+			}
+			return nil
+		})
+	}()
+
+	select {
+	case id := <-ids:
+		return id, nil
+	case err := <-errCh:
+		return "", err
+	case <-time.After(time.Second):
+		t.Fatalf("%s was not registered", request.Hostname)
+		return "", nil
+	}
+}
+
+func TestUpstreamService_LoadBalancing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	request := &v1.WatchTunnelsRequest{Hostname: "rr.example.com", Protocol: "HTTP", PoolSize: 1, LoadBalancing: "ROUND_ROBIN"}
+
+	a, err := watchMember(ctx, t, upstreams, "admin", request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := watchMember(ctx, t, upstreams, "admin", request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	picked := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		u, err := upstreams.Pick("rr.example.com", "/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		picked[u.ID]++
+	}
+
+	if picked[a] != 2 || picked[b] != 2 {
+		t.Fatalf("expected the upstreams to take turns, got %v", picked)
+	}
+
+	// the hostname is shared by the clients of its owner with the same policy only
+	for accessKeyId, other := range map[string]*v1.WatchTunnelsRequest{
+		"admin": {Hostname: "rr.example.com", Protocol: "HTTP", LoadBalancing: "WEIGHTED"},
+		"guest": {Hostname: "rr.example.com", Protocol: "HTTP", LoadBalancing: "ROUND_ROBIN"},
+	} {
+		if _, err := watchMember(ctx, t, upstreams, accessKeyId, other); !errors.Is(err, service.ErrUpstreamExists) {
+			t.Fatalf("expected upstream exists, got %v", err)
+		}
+	}
+
+	exclusive := &v1.WatchTunnelsRequest{Hostname: "exclusive.example.com", Protocol: "HTTP", PoolSize: 1}
+	if _, err := watchMember(ctx, t, upstreams, "admin", exclusive); err != nil {
+		t.Fatal(err)
+	}
+
+	exclusive.LoadBalancing = "ROUND_ROBIN"
+	if _, err := watchMember(ctx, t, upstreams, "admin", exclusive); !errors.Is(err, service.ErrUpstreamExists) {
+		t.Fatalf("expected upstream exists for a hostname owned exclusively, got %v", err)
+	}
+
+	if n := len(upstreams.List("rr.example.com")); n != 2 {
+		t.Fatalf("expected 2 upstreams, got %d", n)
+	}
+}
+
+func TestUpstreamService_Weighted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	heavy, err := watchMember(ctx, t, upstreams, "admin", &v1.WatchTunnelsRequest{
+		Hostname: "weighted.example.com", Protocol: "HTTP", PoolSize: 1, LoadBalancing: "WEIGHTED", Weight: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	light, err := watchMember(ctx, t, upstreams, "admin", &v1.WatchTunnelsRequest{
+		Hostname: "weighted.example.com", Protocol: "HTTP", PoolSize: 1, LoadBalancing: "WEIGHTED",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	picked := make(map[string]int)
+	for i := 0; i < 8; i++ {
+		u, err := upstreams.Pick("weighted.example.com", "/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		picked[u.ID]++
+	}
+
+	if picked[heavy] != 6 || picked[light] != 2 {
+		t.Fatalf("expected the requests split 3 to 1, got %v", picked)
+	}
+}
+
+func TestUpstreamService_LeastConnections(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	request := &v1.WatchTunnelsRequest{Hostname: "lc.example.com", Protocol: "HTTP", PoolSize: 2, LoadBalancing: "LEAST_CONNECTIONS"}
+
+	for i := 0; i < 2; i++ {
+		if _, err := watchMember(ctx, t, upstreams, "admin", request); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// every tunnel taken out goes to the upstream with fewer tunnels in use
+	busy := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		u, err := upstreams.Pick(request.Hostname, "/")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := u.Pool().Acquire(ctx); err != nil {
+			t.Fatal(err)
+		}
+		busy[u.ID]++
+	}
+
+	for id, n := range busy {
+		if n != 2 {
+			t.Fatalf("expected 2 tunnels in use by %s, got %v", id, busy)
+		}
+	}
+}
+
+func TestUpstreamService_Failover(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	request := &v1.WatchTunnelsRequest{Hostname: "failover.example.com", Protocol: "HTTP", PoolSize: 1,
+		LoadBalancing: "ROUND_ROBIN"}
+
+	a, err := watchMember(ctx, t, upstreams, "admin", request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := watchMember(ctx, t, upstreams, "admin", request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := upstreams.SetHealthy("guest", "failover.example.com", a, false); !errors.Is(err, service.ErrUpstreamNotFound) {
+		t.Fatalf("expected the upstream of another access key not found, got %v", err)
+	}
+
+	if _, changed, err := upstreams.SetHealthy("admin", "failover.example.com", a, false); err != nil || !changed {
+		t.Fatalf("expected %s marked unhealthy, got %v", a, err)
+	}
+
+	for i := 0; i < 4; i++ {
+		u, err := upstreams.Pick("failover.example.com", "/")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if u.ID != b {
+			t.Fatalf("expected the healthy upstream %s picked, got %s", b, u.ID)
+		}
+	}
+
+	if _, _, err := upstreams.SetHealthy("admin", "failover.example.com", b, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := upstreams.Pick("failover.example.com", "/"); !errors.Is(err, service.ErrUpstreamUnhealthy) {
+		t.Fatalf("expected upstream unhealthy, got %v", err)
+	}
+
+	if healthy, err := upstreams.Healthy("failover.example.com", "/"); err != nil || healthy {
+		t.Fatalf("expected the hostname unhealthy, got %v %v", healthy, err)
+	}
+
+	if _, changed, err := upstreams.SetHealthy("admin", "failover.example.com", a, true); err != nil || !changed {
+		t.Fatalf("expected %s marked healthy, got %v", a, err)
+	}
+
+	if u, err := upstreams.Pick("failover.example.com", "/"); err != nil || u.ID != a {
+		t.Fatalf("expected %s picked again, got %v", a, err)
+	}
+}
+
+func TestUpstreamService_Routes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	ids := make(map[string]string)
+	for _, prefix := range []string{"", "/v1", "/v1/users/", "/v2"} {
+		request := &v1.WatchTunnelsRequest{Hostname: "api.example.com", Protocol: "HTTP", PoolSize: 1, PathPrefix: prefix}
+
+		id, err := watchMember(ctx, t, upstreams, "admin", request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[service.NormalizePathPrefix(prefix)] = id
+	}
+
+	for path, prefix := range map[string]string{
+		"/":                "",
+		"/v1":              "/v1",
+		"/v1/":             "/v1",
+		"/v1/orders":       "/v1",
+		"/v1/users":        "/v1/users",
+		"/v1/users/42":     "/v1/users",
+		"/v1/usersettings": "/v1",
+		"/v10":             "",
+		"/v2/users":        "/v2",
+	} {
+		u, err := upstreams.Pick("api.example.com", path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if u.ID != ids[prefix] || u.PathPrefix != prefix {
+			t.Fatalf("expected %s routed to %q, got %q", path, prefix, u.PathPrefix)
+		}
+	}
+
+	if n := len(upstreams.List("api.example.com")); n != 4 {
+		t.Fatalf("expected 4 upstreams, got %d", n)
+	}
+
+	// the routes of a hostname share its owner and protocol, a route has one owner
+	for _, tc := range []struct {
+		accessKeyId string
+		request     *v1.WatchTunnelsRequest
+	}{
+		{"guest", &v1.WatchTunnelsRequest{Hostname: "api.example.com", Protocol: "HTTP", PathPrefix: "/v3"}},
+		{"admin", &v1.WatchTunnelsRequest{Hostname: "api.example.com", Protocol: "HTTPS", PathPrefix: "/v3"}},
+		{"admin", &v1.WatchTunnelsRequest{Hostname: "api.example.com", Protocol: "HTTP", PathPrefix: "/v2/"}},
+	} {
+		if _, err := watchMember(ctx, t, upstreams, tc.accessKeyId, tc.request); !errors.Is(err, service.ErrUpstreamExists) {
+			t.Fatalf("expected upstream exists for %s %s, got %v", tc.request.Protocol, tc.request.PathPrefix, err)
+		}
+	}
+
+	for _, request := range []*v1.WatchTunnelsRequest{
+		{Hostname: "tcp.example.com", Protocol: "TCP", PathPrefix: "/v1"},
+		{Hostname: "api.example.com", Protocol: "HTTP", PathPrefix: "/v3", StripPrefix: true, RewritePrefix: "/api"},
+	} {
+		if _, err := watchMember(ctx, t, upstreams, "admin", request); !errors.Is(err, service.ErrInvalidRoute) {
+			t.Fatalf("expected invalid route, got %v", err)
+		}
+	}
+
+	only := &v1.WatchTunnelsRequest{Hostname: "only.example.com", Protocol: "HTTP", PoolSize: 1, PathPrefix: "/v1"}
+	if _, err := watchMember(ctx, t, upstreams, "admin", only); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := upstreams.Pick("only.example.com", "/v2"); !errors.Is(err, service.ErrUpstreamNotFound) {
+		t.Fatalf("expected no route for /v2, got %v", err)
+	}
+}
+
+func TestUpstreamService_Wildcard(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	wildcard, err := watchMember(ctx, t, upstreams, "admin",
+		&v1.WatchTunnelsRequest{Hostname: "*.dev.example.com", Protocol: "HTTP", PoolSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exact, err := watchMember(ctx, t, upstreams, "admin",
+		&v1.WatchTunnelsRequest{Hostname: "a.dev.example.com", Protocol: "HTTP", PoolSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for hostname, id := range map[string]string{
+		"a.dev.example.com":   exact,
+		"B.dev.example.com":   wildcard,
+		"*.dev.example.com":   wildcard,
+		"a.b.dev.example.com": "",
+		"dev.example.com":     "",
+	} {
+		u, err := upstreams.Pick(hostname, "/")
+		if id == "" {
+			if !errors.Is(err, service.ErrUpstreamNotFound) {
+				t.Fatalf("expected %s not found, got %v", hostname, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if u.ID != id {
+			t.Fatalf("expected %s served by %s, got %s", hostname, id, u.ID)
+		}
+	}
+
+	if _, err := upstreams.Lookup("b.dev.example.com", wildcard); err != nil {
+		t.Fatalf("expected the wildcard upstream found by a subdomain, got %v", err)
+	}
+}
+
+func TestUpstreamService_RandomSubdomain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tokens := newTokenService(t, "0123456789abcdef0123456789abcdef")
+
+	disabled := service.NewUpstreamService(tokens, nil, nil, 0, "")
+
+	_, err := watchMember(ctx, t, disabled, "admin", &v1.WatchTunnelsRequest{Protocol: "HTTP"})
+	if !errors.Is(err, service.ErrHostnameRequired) {
+		t.Fatalf("expected hostname required without base domain, got %v", err)
+	}
+
+	upstreams := service.NewUpstreamService(tokens, nil, nil, time.Minute, "Tunnel.example.com.")
+
+	watch := func(ctx context.Context, accessKeyId, resumeId string) *v1.WatchTunnelsResponse {
+		responses := make(chan *v1.WatchTunnelsResponse, 1)
+
+		request := &v1.WatchTunnelsRequest{Protocol: "HTTP", PoolSize: 1, ResumeId: resumeId}
+		go func() {
+			_ = upstreams.Watch(ctx, accessKeyId, request, func(resp *v1.WatchTunnelsResponse) error {
+				select {
+				case responses <- resp:
+				default:
+				}
+				return nil
+			})
+		}()
+
+		select {
+		case resp := <-responses:
+			return resp
+		case <-time.After(time.Second):
+			t.Fatal("no subdomain was allocated")
+			return nil
+		}
+	}
+
+	watchCtx, stop := context.WithCancel(ctx)
+
+	a := watch(watchCtx, "admin", "")
+	b := watch(ctx, "guest", "")
+
+	pattern := regexp.MustCompile(`^[a-z]+-[a-z]+-[0-9]{4}\.tunnel\.example\.com$`)
+	if !pattern.MatchString(a.Hostname) || !pattern.MatchString(b.Hostname) || a.Hostname == b.Hostname {
+		t.Fatalf("unexpected subdomains %s and %s", a.Hostname, b.Hostname)
+	}
+
+	if u, err := upstreams.Get(a.Hostname); err != nil || u.AccessKeyId != "admin" {
+		t.Fatalf("expected %s registered by admin, got %v", a.Hostname, err)
+	}
+
+	// the subdomain is kept by resuming the upstream
+	stop()
+
+	if resumed := watch(ctx, "admin", a.UpstreamId); resumed.Hostname != a.Hostname || resumed.UpstreamId != a.UpstreamId {
+		t.Fatalf("expected %s resumed, got %s", a.Hostname, resumed.Hostname)
+	}
+
+	if other := watch(ctx, "guest", a.UpstreamId); other.Hostname == a.Hostname {
+		t.Fatalf("expected the subdomain of another access key not resumed")
+	}
+}
+
+func TestUpstreamService_RandomSubdomainWildcard(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0,
+		"tunnel.example.com")
+
+	wildcard, err := watchMember(ctx, t, upstreams, "admin",
+		&v1.WatchTunnelsRequest{Hostname: "*.tunnel.example.com", Protocol: "HTTP", PoolSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the subdomains of the wildcard are not handed out to another owner
+	_, err = watchMember(ctx, t, upstreams, "guest", &v1.WatchTunnelsRequest{Protocol: "HTTP", PoolSize: 1})
+	if !errors.Is(err, service.ErrUpstreamExists) {
+		t.Fatalf("expected no subdomain allocated under the wildcard of another owner, got %v", err)
+	}
+
+	if _, err := watchMember(ctx, t, upstreams, "admin", &v1.WatchTunnelsRequest{Protocol: "HTTP", PoolSize: 1}); err != nil {
+		t.Fatalf("expected a subdomain allocated to the owner of the wildcard, got %v", err)
+	}
+
+	if u, err := upstreams.Pick("www.tunnel.example.com", "/"); err != nil || u.ID != wildcard {
+		t.Fatalf("expected the wildcard upstream serving its subdomains, got %v", err)
+	}
+}
+
+func TestUpstreamService_PoolSizeBound(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstreams := service.NewUpstreamService(newTokenService(t, "0123456789abcdef0123456789abcdef"), nil, nil, 0, "")
+
+	responses := make(chan *v1.WatchTunnelsResponse, 1)
+
+	request := &v1.WatchTunnelsRequest{Hostname: "huge.example.com", Protocol: "HTTP", PoolSize: math.MaxInt32}
+	go func() {
+		_ = upstreams.Watch(ctx, "admin", request, func(resp *v1.WatchTunnelsResponse) error {
+			select {
+			case responses <- resp:
+			default:
+			}
+			return nil
+		})
+	}()
+
+	var resp *v1.WatchTunnelsResponse
+	select {
+	case resp = <-responses:
+	case <-time.After(time.Second):
+		t.Fatal("no tunnel was requested")
+	}
+
+	u, err := upstreams.Lookup(resp.Hostname, resp.UpstreamId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if size := u.Pool().Stats().Size; size != 1024 {
+		t.Fatalf("expected the pool size bounded to 1024, got %d", size)
+	}
+}
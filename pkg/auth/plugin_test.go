@@ -21,7 +21,7 @@ func (f *fakePlugin) HasPermission(ctx context.Context, token, domain string) (o
 	panic("implement me")
 }
 
-func (f *fakePlugin) Login(ctx context.Context, accessKeyId, secretAccessKey string) (token string, err error) {
+func (f *fakePlugin) Authenticate(ctx context.Context, accessKeyId, secretAccessKey string) (ok bool, err error) {
 	//TODO implement me
 	panic("implement me")
 }
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var _ PluginInterface = (*StaticPlugin)(nil)
+
+func init() {
+	RegisterPlugin("STATIC", &StaticPlugin{})
+}
+
+// StaticCredential an access key and the domains its owner may use
+type StaticCredential struct {
+	// AccessKeyId the access key id
+	AccessKeyId string `yaml:"access_key_id,omitempty" json:"access_key_id,omitempty"`
+
+	// SecretAccessKey the secret access key
+	SecretAccessKey string `yaml:"secret_access_key,omitempty" json:"secret_access_key,omitempty"`
+
+	// Domains list of domains the owner of the access key may use, a leading
+	// `*.` matches every subdomain of the rest of the pattern
+	Domains []string `yaml:"domains,omitempty" json:"domains,omitempty"`
+}
+
+// StaticPlugin authenticates clients against a fixed list of credentials
+// read from the plugin options
+type StaticPlugin struct {
+	credentials map[string]*StaticCredential
+}
+
+// Setup implements PluginInterface
+func (p *StaticPlugin) Setup(ctx context.Context, opt *PluginOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data := struct {
+		Credentials []*StaticCredential `json:"credentials"`
+	}{}
+
+	if opt != nil && len(*opt) != 0 {
+		if err := opt.Unmarshal(&data); err != nil {
+			return err
+		}
+	}
+
+	p.credentials = make(map[string]*StaticCredential, len(data.Credentials))
+
+	for _, c := range data.Credentials {
+		if _, ok := p.credentials[c.AccessKeyId]; ok {
+			return fmt.Errorf("duplicate access_key_id %s", c.AccessKeyId)
+		}
+		p.credentials[c.AccessKeyId] = c
+	}
+	return nil
+}
+
+// Validate implements PluginInterface
+func (p *StaticPlugin) Validate() error {
+	if len(p.credentials) == 0 {
+		return errors.New("credentials is required field")
+	}
+
+	for id, c := range p.credentials {
+		if id == "" {
+			return errors.New("access_key_id is required field")
+		}
+
+		if c.SecretAccessKey == "" {
+			return fmt.Errorf("secret_access_key of %s is required field", id)
+		}
+	}
+	return nil
+}
+
+// Close implements PluginInterface
+func (p *StaticPlugin) Close() error {
+	return nil
+}
+
+// HasPermission implements PluginInterface
+func (p *StaticPlugin) HasPermission(ctx context.Context, accessKeyId, domain string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	c, ok := p.credentials[accessKeyId]
+	if !ok {
+		return false, nil
+	}
+
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	for _, pattern := range c.Domains {
+		if MatchDomain(strings.ToLower(pattern), domain) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Authenticate implements PluginInterface
+func (p *StaticPlugin) Authenticate(ctx context.Context, accessKeyId, secretAccessKey string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	c, ok := p.credentials[accessKeyId]
+	if !ok {
+		return false, nil
+	}
+	return subtle.ConstantTimeCompare([]byte(c.SecretAccessKey), []byte(secretAccessKey)) == 1, nil
+}
+
+// MatchDomain reports whether domain matches pattern, a pattern with a
+// leading `*.` matches every subdomain of the rest of the pattern. A
+// wildcard domain is matched by the wildcard patterns covering all of its
+// subdomains.
+func MatchDomain(pattern, domain string) bool {
+	if !strings.HasPrefix(pattern, "*.") {
+		return pattern == domain
+	}
+	return strings.HasSuffix(domain, pattern[1:]) && len(domain) > len(pattern)-1
+}
@@ -0,0 +1,88 @@
+package auth_test
+
+import (
+	"context"
+	"github.com/aapelismith/kun/pkg/auth"
+	"testing"
+)
+
+func TestStaticPlugin(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p, err := auth.NewPlugin("STATIC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := auth.PluginOptions(`
+		{
+			"credentials": [
+				{
+					"access_key_id": "admin",
+					"secret_access_key": "secret",
+					"domains": ["www.example.com", "*.dev.example.com"]
+				}
+			]
+		}`,
+	)
+
+	if err := p.Setup(ctx, &opts); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = p.Close()
+	}()
+
+	if ok, err := p.Authenticate(ctx, "admin", "secret"); err != nil || !ok {
+		t.Fatalf("expected valid credentials, got %v %v", ok, err)
+	}
+
+	if ok, _ := p.Authenticate(ctx, "admin", "wrong"); ok {
+		t.Fatal("expected invalid secret access key")
+	}
+
+	if ok, _ := p.Authenticate(ctx, "nobody", "secret"); ok {
+		t.Fatal("expected unknown access key id")
+	}
+
+	cases := map[string]bool{
+		"www.example.com":     true,
+		"WWW.example.com.":    true,
+		"a.dev.example.com":   true,
+		"a.b.dev.example.com": true,
+		"dev.example.com":     false,
+		"example.com":         false,
+		"xdev.example.com":    false,
+		"*.dev.example.com":   true,
+		"*.a.dev.example.com": true,
+		"*.example.com":       false,
+		"*.www.example.com":   false,
+	}
+
+	for domain, want := range cases {
+		if ok, _ := p.HasPermission(ctx, "admin", domain); ok != want {
+			t.Errorf("HasPermission(%s) = %v, want %v", domain, ok, want)
+		}
+	}
+}
+
+func TestStaticPlugin_Validate(t *testing.T) {
+	p, err := auth.NewPlugin("STATIC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Setup(context.Background(), auth.NewPluginOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected error without credentials")
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff computes the delays between the attempts of a failing operation,
+// the delay doubles at every attempt from min up to max. Every delay is
+// jittered between its half and itself, so that the clients dropped at the
+// same time do not reconnect all at once.
+type Backoff struct {
+	min     time.Duration
+	max     time.Duration
+	attempt int
+	rand    *rand.Rand
+}
+
+// Next returns the delay before the next attempt
+func (b *Backoff) Next() time.Duration {
+	d := b.max
+	if b.attempt < 62 && b.min<<b.attempt < b.max && b.min<<b.attempt > 0 {
+		d = b.min << b.attempt
+	}
+	b.attempt++
+
+	half := d / 2
+	return half + time.Duration(b.rand.Int63n(int64(d-half)+1))
+}
+
+// Reset starts over from min, e.g. once the operation succeeded
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
+
+// NewBackoff create Backoff from min up to max
+func NewBackoff(min, max time.Duration) *Backoff {
+	return &Backoff{
+		min:  min,
+		max:  max,
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"github.com/aapelismith/kun/pkg/client"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	b := client.NewBackoff(time.Second, time.Second*10)
+
+	for i, expected := range []time.Duration{1, 2, 4, 8, 10, 10, 10} {
+		expected *= time.Second
+
+		d := b.Next()
+		if d < expected/2 || d > expected {
+			t.Fatalf("expected delay %d between %s and %s, got %s", i, expected/2, expected, d)
+		}
+	}
+
+	b.Reset()
+
+	if d := b.Next(); d < time.Second/2 || d > time.Second {
+		t.Fatalf("expected the delay to start over, got %s", d)
+	}
+
+	// the delay never overflows however long the operation fails
+	for i := 0; i < 100; i++ {
+		if d := b.Next(); d <= 0 || d > time.Second*10 {
+			t.Fatalf("unexpected delay %s", d)
+		}
+	}
+}
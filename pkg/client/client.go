@@ -0,0 +1,487 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/client/config"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Version the version of the client reported at login, set at build time
+var Version = "v0.0.1"
+
+const (
+	// authorizationKey the metadata key carrying the session token
+	authorizationKey = "authorization"
+
+	// refreshBefore the session token is refreshed when it expires within this long
+	refreshBefore = time.Minute
+
+	// dialTimeout the maximum duration to connect the local service
+	dialTimeout = time.Second * 10
+
+	// keepaliveTime the connection to the server is probed when it was idle
+	// for this long, so that a dead network is noticed
+	keepaliveTime = time.Second * 20
+
+	// keepaliveTimeout the connection is closed when the probe is not
+	// answered within this long
+	keepaliveTimeout = time.Second * 10
+
+	// maxReasonLength the longest reason of a health report accepted by the server
+	maxReasonLength = 256
+)
+
+// ErrLoginFailed the access key was refused by the server
+var ErrLoginFailed = errors.New("login failed")
+
+// Client keeps the hostname of its options watched on the server and
+// forwards the tunnels requested by the server to the local service. The
+// watch is reconnected with a jittered exponential backoff whenever it
+// drops, the session token is refreshed by login when it expires, and the
+// upstream registered by the previous watch is resumed. The health of the
+// local service is reported to the server, which routes no request to an
+// unhealthy upstream.
+type Client struct {
+	opts       *config.ClientOptions
+	tunnelOpts *tunnel.Options
+	health     *HealthChecker
+	conn       *grpc.ClientConn
+	api        v1.BackendControllerClient
+
+	mu         sync.Mutex
+	token      string
+	expiredAt  time.Time
+	hostname   string
+	upstreamId string
+	port       int32
+	healthy    bool
+	reason     string
+}
+
+// Run watches the hostname until ctx is done, it returns an error only
+// when the server refuses the client for good
+func (c *Client) Run(ctx context.Context) error {
+	l := log.FromContext(ctx).Sugar()
+
+	backoff := NewBackoff(time.Duration(c.opts.MinBackoff), time.Duration(c.opts.MaxBackoff))
+
+	if c.health != nil {
+		go c.health.Run(ctx, func(healthy bool, reason string) {
+			c.setHealthy(ctx, healthy, reason)
+		})
+	}
+
+	for {
+		registered, err := c.watch(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if registered {
+			backoff.Reset()
+		}
+
+		switch {
+		case errors.Is(err, ErrLoginFailed):
+			return err
+		case status.Code(err) == codes.Unauthenticated:
+			// the session token was revoked or has expired in the meantime
+			c.invalidate()
+		case status.Code(err) == codes.InvalidArgument, status.Code(err) == codes.PermissionDenied,
+			status.Code(err) == codes.Unimplemented:
+			return err
+		}
+
+		delay := backoff.Next()
+		l.Warnf("watch of %s dropped, reconnecting in %s, got: %v", c.Hostname(), delay, err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
+
+// Hostname returns the hostname watched, allocated by the server once
+// registered if the options have none
+func (c *Client) Hostname() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hostname
+}
+
+// Close closes the connection to the server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// login returns the session token, which is refreshed when it expires soon.
+// The lock is not held during the Login call, so that a slow server blocks
+// no reader of the client.
+func (c *Client) login(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	if c.token != "" && time.Until(c.expiredAt) > refreshBefore {
+		token := c.token
+		c.mu.Unlock()
+		return token, nil
+	}
+
+	request := &v1.LoginRequest{
+		Version:         Version,
+		Os:              runtime.GOOS,
+		Arch:            runtime.GOARCH,
+		Pid:             int64(os.Getpid()),
+		Timestamp:       time.Now().Unix(),
+		AccessKeyId:     c.opts.AccessKeyId,
+		SecretAccessKey: c.opts.SecretAccessKey,
+	}
+	c.mu.Unlock()
+
+	resp, err := c.api.Login(ctx, request)
+
+	switch {
+	case status.Code(err) == codes.Unauthenticated, status.Code(err) == codes.InvalidArgument:
+		return "", fmt.Errorf("%w: %v", ErrLoginFailed, err)
+	case err != nil:
+		return "", err
+	}
+
+	expiredAt, err := time.Parse(time.RFC3339, resp.ExpiredAt)
+	if err != nil {
+		return "", fmt.Errorf("unable parse the expiration %q of the session token, got: %v", resp.ExpiredAt, err)
+	}
+
+	c.mu.Lock()
+	c.token, c.expiredAt = resp.Token, expiredAt
+	c.mu.Unlock()
+
+	return resp.Token, nil
+}
+
+// invalidate forgets the session token, the next watch logs in again
+func (c *Client) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = ""
+}
+
+// setHealthy records the health of the local service and reports it
+func (c *Client) setHealthy(ctx context.Context, healthy bool, reason string) {
+	l := log.FromContext(ctx).Sugar()
+
+	if healthy {
+		l.Infof("local service %s of %s is healthy", c.opts.LocalAddr, c.Hostname())
+	} else {
+		l.Warnf("local service %s of %s is unhealthy, got: %s", c.opts.LocalAddr, c.Hostname(), reason)
+	}
+
+	c.mu.Lock()
+	c.healthy, c.reason = healthy, truncate(reason, maxReasonLength)
+	c.mu.Unlock()
+
+	c.reportHealth(ctx)
+}
+
+// reportHealth reports the health of the local service to the upstream
+// registered by the watch, the next registration reports it again if the
+// upstream is not registered yet or the report fails
+func (c *Client) reportHealth(ctx context.Context) {
+	l := log.FromContext(ctx).Sugar()
+
+	token, err := c.login(ctx)
+	if err != nil {
+		l.Warnf("unable report the health of %s, got: %v", c.Hostname(), err)
+		return
+	}
+
+	c.mu.Lock()
+	request := &v1.ReportHealthRequest{
+		Hostname:   c.hostname,
+		UpstreamId: c.upstreamId,
+		Healthy:    c.healthy,
+		Reason:     c.reason,
+	}
+	c.mu.Unlock()
+
+	if request.UpstreamId == "" {
+		return
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, authorizationKey, "Bearer "+token)
+
+	if _, err := c.api.ReportHealth(ctx, request); err != nil {
+		l.Warnf("unable report the health of %s, got: %v", request.Hostname, err)
+	}
+}
+
+// watch watches the hostname and connects the tunnels requested by the
+// server until the watch drops. It reports whether the hostname was
+// registered, or resumed, by the server.
+func (c *Client) watch(ctx context.Context) (bool, error) {
+	l := log.FromContext(ctx).Sugar()
+
+	token, err := c.login(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	request := &v1.WatchTunnelsRequest{
+		Hostname:        c.opts.Hostname,
+		Protocol:        c.opts.Protocol,
+		PoolSize:        int32(c.opts.PoolSize),
+		MinPoolSize:     int32(c.opts.MinPoolSize),
+		MaxPoolSize:     int32(c.opts.MaxPoolSize),
+		Port:            c.port,
+		Http2:           c.opts.HTTP2,
+		Multiplex:       c.opts.Multiplex,
+		Compression:     c.opts.Compression,
+		WindowSize:      int32(c.opts.WindowSize),
+		ResumeId:        c.upstreamId,
+		LoadBalancing:   c.opts.LoadBalancing,
+		Weight:          int32(c.opts.Weight),
+		PathPrefix:      c.opts.PathPrefix,
+		StripPrefix:     c.opts.StripPrefix,
+		RewritePrefix:   c.opts.RewritePrefix,
+		RequestHeaders:  headerRules(c.opts.RequestHeaders),
+		ResponseHeaders: headerRules(c.opts.ResponseHeaders),
+		AllowCidrs:      c.opts.AllowCIDRs,
+		DenyCidrs:       c.opts.DenyCIDRs,
+		BasicAuth:       c.opts.BasicAuth,
+		BearerTokens:    c.opts.BearerTokens,
+	}
+	c.mu.Unlock()
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watchCtx = metadata.AppendToOutgoingContext(watchCtx, authorizationKey, "Bearer "+token)
+
+	stream, err := c.api.WatchTunnels(watchCtx, request)
+	if err != nil {
+		return false, err
+	}
+
+	registered := false
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return registered, err
+		}
+
+		first := !registered
+		if first {
+			registered = true
+
+			if resp.UpstreamId == request.ResumeId {
+				l.Infof("upstream %s of %s is resumed", resp.UpstreamId, resp.Hostname)
+			} else {
+				l.Infof("upstream %s of %s is registered, port %d, compression %q",
+					resp.UpstreamId, resp.Hostname, resp.Port, resp.Compression)
+			}
+		}
+
+		c.mu.Lock()
+		c.upstreamId = resp.UpstreamId
+		if resp.Hostname != "" {
+			c.hostname = resp.Hostname
+		}
+		if resp.Port != 0 {
+			// the same port is requested if the upstream could not be resumed
+			c.port = resp.Port
+		}
+		c.mu.Unlock()
+
+		if first && c.health != nil {
+			// a new upstream starts healthy, a resumed one may have missed a report
+			go c.reportHealth(ctx)
+		}
+
+		// the tunnels outlive the watch, they keep serving while it reconnects
+		go c.connect(ctx, resp)
+	}
+}
+
+// connect connects the tunnel requested by resp and serves it
+func (c *Client) connect(ctx context.Context, resp *v1.WatchTunnelsResponse) {
+	l := log.FromContext(ctx).Sugar()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, tunnel.TokenKey, resp.TunnelToken)
+
+	stream, err := c.api.ConnectTunnel(ctx)
+	if err != nil {
+		l.Debugf("unable connect tunnel %s of %s, got: %v", resp.TraceId, resp.Hostname, err)
+		return
+	}
+
+	addr := resp.Hostname + "/" + resp.TraceId
+
+	// the codec negotiated by the watch, nil if none
+	codec, _ := tunnel.GetCodec(resp.Compression)
+
+	// the window negotiated by the watch
+	opts := c.tunnelOpts.WithWindowSize(int(resp.WindowSize))
+
+	if c.opts.Multiplex {
+		session := tunnel.NewClientSession(stream, opts, addr)
+		session.SetCodec(codec)
+
+		defer func() {
+			_ = session.Close()
+		}()
+
+		for {
+			conn, err := session.Accept()
+			if err != nil {
+				return
+			}
+			go c.serve(ctx, conn)
+		}
+	}
+
+	conn := tunnel.NewConn(stream, opts, addr)
+	conn.SetCodec(codec)
+
+	c.serve(ctx, conn)
+}
+
+// serve forwards the tunnel to the local service once the server opened it
+func (c *Client) serve(ctx context.Context, conn *tunnel.Conn) {
+	l := log.FromContext(ctx).Sugar()
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	select {
+	case <-conn.Opened():
+	case <-conn.Done():
+		return
+	}
+
+	network := "tcp"
+	if c.opts.Protocol == "UDP" {
+		network = "udp"
+	}
+
+	local, err := net.DialTimeout(network, c.opts.LocalAddr, dialTimeout)
+	if err != nil {
+		l.Warnf("unable connect %s of %s, got: %v", c.opts.LocalAddr, c.Hostname(), err)
+		return
+	}
+
+	if network == "udp" {
+		pipeDatagrams(tunnel.NewDatagramConn(conn), local)
+		return
+	}
+	tunnel.Pipe(conn, local)
+}
+
+// headerRules returns the header rules of the config sent to the server
+func headerRules(rules []config.HeaderRule) []*v1.HeaderRule {
+	result := make([]*v1.HeaderRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, &v1.HeaderRule{
+			Action:  rule.Action,
+			Name:    rule.Name,
+			Value:   rule.Value,
+			Pattern: rule.Pattern,
+		})
+	}
+	return result
+}
+
+// newTransportCredentials create the credentials verifying the server
+func newTransportCredentials(opts *config.ClientOptions) (credentials.TransportCredentials, error) {
+	if opts.Insecure {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipTLSVerify,
+	}
+
+	if opts.TrustedCAFile != "" {
+		data, err := os.ReadFile(opts.TrustedCAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate found in %s", opts.TrustedCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// NewClient create Client connecting the server of opts, the local service
+// is probed by healthOpts and the tunnels speak the protocol of tunnelOpts
+func NewClient(opts *config.ClientOptions, healthOpts *config.HealthCheckOptions, tunnelOpts *tunnel.Options) (*Client, error) {
+	creds, err := newTransportCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(opts.ServerAddr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepaliveTime,
+			Timeout:             keepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		opts:       opts,
+		tunnelOpts: tunnelOpts,
+		health:     NewHealthChecker(healthOpts, opts.LocalAddr),
+		conn:       conn,
+		api:        v1.NewBackendControllerClient(conn),
+		hostname:   opts.Hostname,
+		healthy:    true,
+	}, nil
+}
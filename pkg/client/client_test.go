@@ -0,0 +1,338 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"context"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	apiconfig "github.com/aapelismith/kun/pkg/apiserver/config"
+	"github.com/aapelismith/kun/pkg/apiserver/controller"
+	"github.com/aapelismith/kun/pkg/apiserver/middleware"
+	"github.com/aapelismith/kun/pkg/apiserver/proxy"
+	"github.com/aapelismith/kun/pkg/apiserver/service"
+	"github.com/aapelismith/kun/pkg/auth"
+	"github.com/aapelismith/kun/pkg/client"
+	"github.com/aapelismith/kun/pkg/client/config"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"google.golang.org/grpc"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const pluginOptions = `
+	{
+		"credentials": [
+			{
+				"access_key_id": "admin",
+				"secret_access_key": "secret",
+				"domains": ["*.dev.example.com"]
+			}
+		]
+	}`
+
+// backend the backend api of a server, which can be restarted at the same address
+type backend struct {
+	t          *testing.T
+	addr       string
+	tokens     *service.TokenService
+	upstreams  *service.UpstreamService
+	controller *controller.BackendController
+	server     *grpc.Server
+}
+
+func (b *backend) start() {
+	ln, err := net.Listen("tcp", b.addr)
+	if err != nil {
+		b.t.Fatal(err)
+	}
+	b.addr = ln.Addr().String()
+
+	authenticator := middleware.NewAuth(b.tokens,
+		"/"+v1.BackendController_ServiceDesc.ServiceName+"/Login",
+		"/"+v1.BackendController_ServiceDesc.ServiceName+"/ConnectTunnel",
+	)
+
+	b.server = grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamServerInterceptor()),
+	)
+	v1.RegisterBackendControllerServer(b.server, b.controller)
+
+	go func() {
+		_ = b.server.Serve(ln)
+	}()
+}
+
+func newBackend(t *testing.T) *backend {
+	opts := &apiconfig.TokenOptions{}
+	opts.SetDefaults()
+	opts.SigningKeys = []string{"0123456789abcdef0123456789abcdef"}
+
+	tokens, err := service.NewTokenService(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plugin, err := auth.NewPlugin("STATIC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pluginOpts := auth.PluginOptions(pluginOptions)
+	if err := plugin.Setup(context.Background(), &pluginOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	tunnelOpts := tunnel.NewOptions()
+	tunnelOpts.SetDefaults()
+
+	upstreams := service.NewUpstreamService(tokens, nil, nil, time.Second*10, "")
+	certificates := service.NewCertificateService(service.NewMemoryCertificateStore(), nil, nil)
+
+	return &backend{
+		t:          t,
+		addr:       "127.0.0.1:0",
+		tokens:     tokens,
+		upstreams:  upstreams,
+		controller: controller.NewBackendController(plugin, tokens, upstreams, certificates, nil, nil, tunnelOpts),
+	}
+}
+
+// waitUpstream waits until hostname is watched, then returns its id
+func waitUpstream(t *testing.T, upstreams *service.UpstreamService, hostname string) string {
+	deadline := time.Now().Add(time.Second * 10)
+
+	for time.Now().Before(deadline) {
+		if u, err := upstreams.Get(hostname); err == nil && u.Pool().Idle() > 0 {
+			return u.ID
+		}
+		time.Sleep(time.Millisecond * 20)
+	}
+
+	t.Fatalf("expected %s watched", hostname)
+	return ""
+}
+
+// get requests the frontend for hostname and returns the body
+func get(t *testing.T, frontend *httptest.Server, hostname string) string {
+	req, err := http.NewRequest(http.MethodGet, frontend.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = hostname
+
+	resp, err := frontend.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.StatusCode, body)
+	}
+	return string(body)
+}
+
+func TestClient_Resume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "hello "+r.Host)
+	}))
+	defer local.Close()
+
+	b := newBackend(t)
+	b.start()
+
+	frontend := httptest.NewServer(proxy.NewHTTPProxy(b.upstreams, nil, nil, nil))
+	defer frontend.Close()
+
+	opts := new(config.ClientOptions)
+	opts.SetDefaults()
+	opts.ServerAddr = b.addr
+	opts.Insecure = true
+	opts.AccessKeyId, opts.SecretAccessKey = "admin", "secret"
+	opts.Hostname = "a.dev.example.com"
+	opts.LocalAddr = local.Listener.Addr().String()
+	opts.PoolSize = 1
+
+	if err := opts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tunnelOpts := tunnel.NewOptions()
+	tunnelOpts.SetDefaults()
+
+	c, err := client.NewClient(opts, nil, tunnelOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- c.Run(ctx)
+	}()
+
+	id := waitUpstream(t, b.upstreams, opts.Hostname)
+
+	if body := get(t, frontend, opts.Hostname); body != "hello a.dev.example.com" {
+		t.Fatalf("unexpected body %q", body)
+	}
+
+	// drop every connection of the client, as a network outage would
+	b.server.Stop()
+	b.start()
+
+	// the tunnels of the dropped connection are gone, wait for new ones
+	u, err := b.upstreams.Get(opts.Hostname)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(time.Second * 10)
+	for u.Pool().Idle() > 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond * 20)
+	}
+
+	if resumed := waitUpstream(t, b.upstreams, opts.Hostname); resumed != id {
+		t.Fatalf("expected upstream %s resumed, got %s", id, resumed)
+	}
+
+	if body := get(t, frontend, opts.Hostname); body != "hello a.dev.example.com" {
+		t.Fatalf("unexpected body %q", body)
+	}
+
+	cancel()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("expected the client stopped")
+	}
+
+	b.server.Stop()
+}
+
+func TestClient_LoginFailed(t *testing.T) {
+	b := newBackend(t)
+	b.start()
+	defer b.server.Stop()
+
+	opts := new(config.ClientOptions)
+	opts.SetDefaults()
+	opts.ServerAddr = b.addr
+	opts.Insecure = true
+	opts.AccessKeyId, opts.SecretAccessKey = "admin", "wrong"
+	opts.Hostname = "a.dev.example.com"
+
+	tunnelOpts := tunnel.NewOptions()
+	tunnelOpts.SetDefaults()
+
+	c, err := client.NewClient(opts, nil, tunnelOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if err := c.Run(context.Background()); err == nil {
+		t.Fatal("expected the client to give up when the access key is refused")
+	}
+}
+
+// hungBackend the backend api whose Login never answers
+type hungBackend struct {
+	v1.UnimplementedBackendControllerServer
+	called chan struct{}
+}
+
+func (b *hungBackend) Login(ctx context.Context, _ *v1.LoginRequest) (*v1.LoginResponse, error) {
+	close(b.called)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestClient_LoginHung(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hung := &hungBackend{called: make(chan struct{})}
+
+	server := grpc.NewServer()
+	v1.RegisterBackendControllerServer(server, hung)
+	go func() {
+		_ = server.Serve(ln)
+	}()
+	defer server.Stop()
+
+	opts := new(config.ClientOptions)
+	opts.SetDefaults()
+	opts.ServerAddr = ln.Addr().String()
+	opts.Insecure = true
+	opts.AccessKeyId, opts.SecretAccessKey = "admin", "secret"
+	opts.Hostname = "a.dev.example.com"
+
+	tunnelOpts := tunnel.NewOptions()
+	tunnelOpts.SetDefaults()
+
+	c, err := client.NewClient(opts, nil, tunnelOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = c.Run(ctx)
+	}()
+
+	select {
+	case <-hung.called:
+	case <-time.After(time.Second * 10):
+		t.Fatal("expected the client to log in")
+	}
+
+	// the readers of the client are not blocked by the pending Login
+	hostname := make(chan string, 1)
+	go func() {
+		hostname <- c.Hostname()
+	}()
+
+	select {
+	case h := <-hostname:
+		if h != "a.dev.example.com" {
+			t.Fatalf("unexpected hostname %s", h)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected Hostname not blocked by the pending Login")
+	}
+}
@@ -0,0 +1,575 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"github.com/aapelismith/kun/pkg/log"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"github.com/aapelismith/kun/pkg/types"
+	"github.com/spf13/pflag"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"math"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// protocols the protocols an upstream is served with by the frontend
+	protocols = []string{"HTTP", "HTTPS", "TLS", "TCP", "UDP"}
+
+	// policies the load balancing policies of a hostname watched by many clients
+	policies = []string{"", "ROUND_ROBIN", "LEAST_CONNECTIONS", "WEIGHTED"}
+
+	// hostnamePattern the hostnames accepted by the server, with an optional leading wildcard
+	hostnamePattern = regexp.MustCompile(`^(\*\.)?([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.?$`)
+
+	// pathPattern the path prefixes accepted by the server, empty included
+	pathPattern = regexp.MustCompile(`^(/[A-Za-z0-9._~-]+)*/?$`)
+
+	// headerActions the actions of the header rules
+	headerActions = []string{"ADD", "SET", "REMOVE", "REPLACE"}
+
+	// bearerTokenPattern the token68 syntax of the Bearer credentials
+	bearerTokenPattern = regexp.MustCompile(`^[A-Za-z0-9._~+/-]+=*$`)
+
+	// headerNamePattern the header names, tokens of RFC 7230
+	headerNamePattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+)
+
+// HeaderRule a rewrite of a header of the requests forwarded to the local
+// service or of its responses
+type HeaderRule struct {
+	// Action one of ADD, SET, REMOVE and REPLACE
+	Action string `yaml:"action,omitempty" json:"action,omitempty"`
+
+	// Name the name of the header, Host rewrites the Host of the requests
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+
+	// Value the value added or set, or the replacement of the pattern which
+	// may refer to its submatches as $1
+	Value string `yaml:"value,omitempty" json:"value,omitempty"`
+
+	// Pattern the regular expression replaced in the values of the header by REPLACE
+	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty"`
+}
+
+// Validate verify the rule and return an error if correct
+func (r *HeaderRule) Validate() error {
+	if !slices.Contains(headerActions, r.Action) {
+		return fmt.Errorf("%s is an unknown header action", r.Action)
+	}
+
+	if !headerNamePattern.MatchString(r.Name) {
+		return fmt.Errorf("'%s' is not a header name", r.Name)
+	}
+
+	if r.Action == "REPLACE" {
+		if r.Pattern == "" {
+			return fmt.Errorf("pattern of %s is required by REPLACE", r.Name)
+		}
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return fmt.Errorf("pattern of %s is invalid, got: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+// ClientOptions the upstream watched by the client and the server it connects to
+type ClientOptions struct {
+	// ServerAddr the address of the backend api of the server
+	ServerAddr string `yaml:"server_addr,omitempty" json:"server_addr,omitempty"`
+
+	// Insecure connect the server without tls
+	Insecure bool `yaml:"insecure,omitempty" json:"insecure,omitempty"`
+
+	// TrustedCAFile the certificate authority verifying the server, the
+	// system roots are used if empty
+	TrustedCAFile string `yaml:"trusted_ca_file,omitempty" json:"trusted_ca_file,omitempty"`
+
+	// InsecureSkipTLSVerify skip server certificate verification (CAUTION: this
+	// option should be enabled only for testing purposes)
+	InsecureSkipTLSVerify bool `yaml:"insecure_skip_tls_verify,omitempty" json:"insecure_skip_tls_verify,omitempty"`
+
+	// AccessKeyId the access key id logging in the server
+	AccessKeyId string `yaml:"access_key_id,omitempty" json:"access_key_id,omitempty"`
+
+	// SecretAccessKey the secret of the access key
+	SecretAccessKey string `yaml:"secret_access_key,omitempty" json:"secret_access_key,omitempty"`
+
+	// Hostname the hostname watched by the client, a leading `*.` watches
+	// every subdomain of the rest. The server allocates a random subdomain
+	// of its base domain if empty.
+	Hostname string `yaml:"hostname,omitempty" json:"hostname,omitempty"`
+
+	// Protocol the protocol the hostname is served with by the frontend,
+	// one of HTTP, HTTPS, TLS, TCP and UDP
+	Protocol string `yaml:"protocol,omitempty" json:"protocol,omitempty"`
+
+	// Port the public port requested for a TCP or UDP upstream, a free
+	// port is allocated if 0
+	Port int `yaml:"port,omitempty" json:"port,omitempty"`
+
+	// LocalAddr the address of the local service the tunnels are forwarded to
+	LocalAddr string `yaml:"local_addr,omitempty" json:"local_addr,omitempty"`
+
+	// PoolSize the number of idle tunnels kept ready by the server
+	PoolSize int `yaml:"pool_size,omitempty" json:"pool_size,omitempty"`
+
+	// MinPoolSize the smallest size the pool is scaled down to
+	MinPoolSize int `yaml:"min_pool_size,omitempty" json:"min_pool_size,omitempty"`
+
+	// MaxPoolSize the largest size the pool is scaled up to by the server,
+	// starting at PoolSize, the pool size is fixed if 0
+	MaxPoolSize int `yaml:"max_pool_size,omitempty" json:"max_pool_size,omitempty"`
+
+	// LoadBalancing the policy balancing the requests between the clients
+	// watching the hostname, one of ROUND_ROBIN, LEAST_CONNECTIONS and
+	// WEIGHTED. The hostname is owned by the client exclusively if empty.
+	LoadBalancing string `yaml:"load_balancing,omitempty" json:"load_balancing,omitempty"`
+
+	// Weight the share of the requests given to the client by the WEIGHTED policy
+	Weight int `yaml:"weight,omitempty" json:"weight,omitempty"`
+
+	// PathPrefix the path prefix of the requests of an HTTP or HTTPS hostname
+	// routed to the client, the whole hostname if empty. The longest prefix
+	// matching the path of a request wins.
+	PathPrefix string `yaml:"path_prefix,omitempty" json:"path_prefix,omitempty"`
+
+	// StripPrefix the path prefix is removed from the path of the requests
+	// forwarded to the local service
+	StripPrefix bool `yaml:"strip_prefix,omitempty" json:"strip_prefix,omitempty"`
+
+	// RewritePrefix the path prefix is replaced by this one in the path of
+	// the requests forwarded to the local service
+	RewritePrefix string `yaml:"rewrite_prefix,omitempty" json:"rewrite_prefix,omitempty"`
+
+	// RequestHeaders the rules rewriting the headers of the requests of an
+	// HTTP or HTTPS hostname forwarded to the local service, in order
+	RequestHeaders []HeaderRule `yaml:"request_headers,omitempty" json:"request_headers,omitempty"`
+
+	// ResponseHeaders the rules rewriting the headers of the responses of
+	// the local service, in order
+	ResponseHeaders []HeaderRule `yaml:"response_headers,omitempty" json:"response_headers,omitempty"`
+
+	// AllowCIDRs the CIDR ranges, or single addresses, of the clients allowed
+	// to reach the hostname, every client if empty
+	AllowCIDRs []string `yaml:"allow_cidrs,omitempty" json:"allow_cidrs,omitempty"`
+
+	// DenyCIDRs the CIDR ranges, or single addresses, of the clients refused
+	// even if they are allowed by AllowCIDRs
+	DenyCIDRs []string `yaml:"deny_cidrs,omitempty" json:"deny_cidrs,omitempty"`
+
+	// BasicAuth the username:password pairs of the Basic credentials the
+	// frontend requires from the clients of the hostname
+	BasicAuth []string `yaml:"basic_auth,omitempty" json:"basic_auth,omitempty"`
+
+	// BearerTokens the static tokens the frontend accepts as Bearer
+	// credentials from the clients of the hostname
+	BearerTokens []string `yaml:"bearer_tokens,omitempty" json:"bearer_tokens,omitempty"`
+
+	// HTTP2 the local service speaks HTTP/2 without tls (h2c)
+	HTTP2 bool `yaml:"http2,omitempty" json:"http2,omitempty"`
+
+	// Multiplex every tunnel is a session carrying many logical streams
+	Multiplex bool `yaml:"multiplex,omitempty" json:"multiplex,omitempty"`
+
+	// Compression the codecs offered to the server for compressing the
+	// tunnels, in the order of preference, no compression if empty
+	Compression []string `yaml:"compression,omitempty" json:"compression,omitempty"`
+
+	// WindowSize the flow control window requested for the tunnels of the
+	// hostname, bounded by the server, the window of the server if 0
+	WindowSize int `yaml:"window_size,omitempty" json:"window_size,omitempty"`
+
+	// MinBackoff the delay before reconnecting the server the first time
+	// the watch dropped, it doubles at every failure up to MaxBackoff
+	MinBackoff types.Duration `yaml:"min_backoff,omitempty" json:"min_backoff,omitempty"`
+
+	// MaxBackoff the maximum delay before reconnecting the server
+	MaxBackoff types.Duration `yaml:"max_backoff,omitempty" json:"max_backoff,omitempty"`
+}
+
+// SetDefaults sets the default values.
+func (o *ClientOptions) SetDefaults() {
+	o.ServerAddr = "127.0.0.1:8090"
+	o.Protocol = "HTTP"
+	o.LocalAddr = "127.0.0.1:80"
+	o.PoolSize = 4
+	o.Compression = []string{tunnel.CodecZstd, tunnel.CodecSnappy, tunnel.CodecGzip}
+	o.MinBackoff = types.Duration(time.Millisecond * 500)
+	o.MaxBackoff = types.Duration(time.Second * 30)
+}
+
+// AddFlags add client related command line parameters
+func (o *ClientOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ServerAddr, "client.server-addr", o.ServerAddr, "The address of the backend api of the server")
+
+	fs.BoolVar(&o.Insecure, "client.insecure", o.Insecure, "Connect the server without tls")
+
+	fs.StringVar(&o.TrustedCAFile, "client.trusted-ca-file", o.TrustedCAFile, "The certificate authority "+
+		"verifying the server, the system roots are used if empty")
+
+	fs.BoolVar(&o.InsecureSkipTLSVerify, "client.insecure-skip-tls-verify", o.InsecureSkipTLSVerify,
+		"skip server certificate verification (CAUTION: this option should be enabled only for testing purposes)")
+
+	fs.StringVar(&o.AccessKeyId, "client.access-key-id", o.AccessKeyId, "The access key id logging in the server")
+
+	fs.StringVar(&o.SecretAccessKey, "client.secret-access-key", o.SecretAccessKey, "The secret of the access key")
+
+	fs.StringVar(&o.Hostname, "client.hostname", o.Hostname, "The hostname watched by the client, "+
+		"a leading '*.' watches every subdomain of the rest. The server allocates a random subdomain if empty")
+
+	fs.StringVar(&o.Protocol, "client.protocol", o.Protocol, "The protocol the hostname is served with by "+
+		"the frontend, one of HTTP, HTTPS, TLS, TCP and UDP")
+
+	fs.IntVar(&o.Port, "client.port", o.Port, "The public port requested for a TCP or UDP upstream, "+
+		"a free port is allocated if 0")
+
+	fs.StringVar(&o.LocalAddr, "client.local-addr", o.LocalAddr, "The address of the local service the "+
+		"tunnels are forwarded to")
+
+	fs.IntVar(&o.PoolSize, "client.pool-size", o.PoolSize, "The number of idle tunnels kept ready by the server")
+
+	fs.IntVar(&o.MinPoolSize, "client.min-pool-size", o.MinPoolSize, "The smallest size the pool is scaled down to")
+
+	fs.IntVar(&o.MaxPoolSize, "client.max-pool-size", o.MaxPoolSize, "The largest size the pool is scaled up "+
+		"to by the server, starting at pool-size, the pool size is fixed if 0")
+
+	fs.StringVar(&o.LoadBalancing, "client.load-balancing", o.LoadBalancing, "The policy balancing the "+
+		"requests between the clients watching the hostname, one of ROUND_ROBIN, LEAST_CONNECTIONS and WEIGHTED. "+
+		"The hostname is owned by the client exclusively if empty")
+
+	fs.IntVar(&o.Weight, "client.weight", o.Weight, "The share of the requests given to the client by the "+
+		"WEIGHTED policy")
+
+	fs.StringVar(&o.PathPrefix, "client.path-prefix", o.PathPrefix, "The path prefix of the requests of an "+
+		"HTTP or HTTPS hostname routed to the client, the whole hostname if empty")
+
+	fs.BoolVar(&o.StripPrefix, "client.strip-prefix", o.StripPrefix, "Remove the path prefix from the path "+
+		"of the requests forwarded to the local service")
+
+	fs.StringVar(&o.RewritePrefix, "client.rewrite-prefix", o.RewritePrefix, "Replace the path prefix by this "+
+		"one in the path of the requests forwarded to the local service")
+
+	fs.StringSliceVar(&o.AllowCIDRs, "client.allow-cidrs", o.AllowCIDRs, "The CIDR ranges, or single "+
+		"addresses, of the clients allowed to reach the hostname, every client if empty")
+
+	fs.StringSliceVar(&o.DenyCIDRs, "client.deny-cidrs", o.DenyCIDRs, "The CIDR ranges, or single addresses, "+
+		"of the clients refused even if they are allowed by allow-cidrs")
+
+	fs.StringSliceVar(&o.BasicAuth, "client.basic-auth", o.BasicAuth, "The username:password pairs of the "+
+		"Basic credentials the frontend requires from the clients of the hostname")
+
+	fs.StringSliceVar(&o.BearerTokens, "client.bearer-tokens", o.BearerTokens, "The static tokens the "+
+		"frontend accepts as Bearer credentials from the clients of the hostname")
+
+	fs.BoolVar(&o.HTTP2, "client.http2", o.HTTP2, "The local service speaks HTTP/2 without tls (h2c)")
+
+	fs.BoolVar(&o.Multiplex, "client.multiplex", o.Multiplex, "Every tunnel is a session carrying many "+
+		"logical streams")
+
+	fs.StringSliceVar(&o.Compression, "client.compression", o.Compression, "The codecs offered to the server "+
+		"for compressing the tunnels, in the order of preference, no compression if empty")
+
+	fs.IntVar(&o.WindowSize, "client.window-size", o.WindowSize, "The flow control window requested for "+
+		"the tunnels of the hostname, bounded by the server, the window of the server if 0")
+
+	fs.Var(&o.MinBackoff, "client.min-backoff", "The delay before reconnecting the server the first time "+
+		"the watch dropped, it doubles at every failure up to max-backoff")
+
+	fs.Var(&o.MaxBackoff, "client.max-backoff", "The maximum delay before reconnecting the server")
+}
+
+// Validate verify the configuration and return an error if correct
+func (o *ClientOptions) Validate() error {
+	if o.ServerAddr == "" {
+		return fmt.Errorf("server_addr is required field")
+	}
+
+	if o.TrustedCAFile != "" {
+		stat, err := os.Stat(o.TrustedCAFile)
+		if err != nil {
+			return err
+		}
+		if !stat.Mode().IsRegular() {
+			return fmt.Errorf("trusted_ca_file '%s' is not regular file", o.TrustedCAFile)
+		}
+	}
+
+	if o.AccessKeyId == "" || o.SecretAccessKey == "" {
+		return fmt.Errorf("access_key_id and secret_access_key are required fields")
+	}
+
+	if o.Hostname != "" && !hostnamePattern.MatchString(o.Hostname) {
+		return fmt.Errorf("hostname '%s' is not a hostname", o.Hostname)
+	}
+
+	if !slices.Contains(protocols, o.Protocol) {
+		return fmt.Errorf("%s is an unknown protocol", o.Protocol)
+	}
+
+	if o.Port < 0 || o.Port > 65535 {
+		return fmt.Errorf("port must be between 0 and 65535")
+	}
+
+	if o.LocalAddr == "" {
+		return fmt.Errorf("local_addr is required field")
+	}
+
+	if o.PoolSize < 0 || o.PoolSize > 1024 {
+		return fmt.Errorf("pool_size must be between 0 and 1024")
+	}
+
+	if o.MinPoolSize < 0 || o.MaxPoolSize < 0 || o.MaxPoolSize > 1024 {
+		return fmt.Errorf("min_pool_size and max_pool_size must be between 0 and 1024")
+	}
+
+	if o.MaxPoolSize > 0 && o.MinPoolSize > o.MaxPoolSize {
+		return fmt.Errorf("min_pool_size must not be greater than max_pool_size")
+	}
+
+	if !slices.Contains(policies, o.LoadBalancing) {
+		return fmt.Errorf("%s is an unknown load balancing policy", o.LoadBalancing)
+	}
+
+	if o.Weight < 0 || o.Weight > 100 {
+		return fmt.Errorf("weight must be between 0 and 100")
+	}
+
+	if o.PathPrefix != "" || o.StripPrefix || o.RewritePrefix != "" {
+		if o.Protocol != "HTTP" && o.Protocol != "HTTPS" {
+			return fmt.Errorf("%s hostnames are not routed by path", o.Protocol)
+		}
+
+		if !pathPattern.MatchString(o.PathPrefix) || !pathPattern.MatchString(o.RewritePrefix) {
+			return fmt.Errorf("path_prefix and rewrite_prefix must be paths such as /v1")
+		}
+
+		if o.StripPrefix && o.RewritePrefix != "" {
+			return fmt.Errorf("strip_prefix and rewrite_prefix are mutually exclusive")
+		}
+	}
+
+	if (len(o.RequestHeaders) > 0 || len(o.ResponseHeaders) > 0) && o.Protocol != "HTTP" && o.Protocol != "HTTPS" {
+		return fmt.Errorf("the headers of %s hostnames are not rewritten", o.Protocol)
+	}
+
+	for i := range o.RequestHeaders {
+		if err := o.RequestHeaders[i].Validate(); err != nil {
+			return fmt.Errorf("request_headers[%d]: %w", i, err)
+		}
+	}
+
+	for i := range o.ResponseHeaders {
+		if err := o.ResponseHeaders[i].Validate(); err != nil {
+			return fmt.Errorf("response_headers[%d]: %w", i, err)
+		}
+	}
+
+	if err := validateCIDRs("allow_cidrs", o.AllowCIDRs); err != nil {
+		return err
+	}
+
+	if err := validateCIDRs("deny_cidrs", o.DenyCIDRs); err != nil {
+		return err
+	}
+
+	if (len(o.BasicAuth) > 0 || len(o.BearerTokens) > 0) && o.Protocol != "HTTP" && o.Protocol != "HTTPS" {
+		return fmt.Errorf("the clients of %s hostnames are not gated", o.Protocol)
+	}
+
+	for i, pair := range o.BasicAuth {
+		if username, password, _ := strings.Cut(pair, ":"); username == "" || password == "" {
+			return fmt.Errorf("basic_auth[%d] must be username:password", i)
+		}
+	}
+
+	for i, token := range o.BearerTokens {
+		if !bearerTokenPattern.MatchString(token) {
+			return fmt.Errorf("bearer_tokens[%d] is no bearer token", i)
+		}
+	}
+
+	for _, codec := range o.Compression {
+		if _, ok := tunnel.GetCodec(codec); !ok {
+			return fmt.Errorf("%s is an unknown compression codec", codec)
+		}
+	}
+
+	if o.WindowSize != 0 && (o.WindowSize < tunnel.InitialWindowSize || o.WindowSize > math.MaxInt32) {
+		return fmt.Errorf("window_size must be 0 or between %d and %d", tunnel.InitialWindowSize, math.MaxInt32)
+	}
+
+	if o.MinBackoff <= 0 || o.MaxBackoff < o.MinBackoff {
+		return fmt.Errorf("min_backoff must be greater than 0 and max_backoff at least min_backoff")
+	}
+	return nil
+}
+
+// validateCIDRs returns an error if an item of the field is no CIDR range or address
+func validateCIDRs(field string, cidrs []string) error {
+	for i, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil && net.ParseIP(cidr) == nil {
+			return fmt.Errorf("%s[%d] '%s' is no CIDR or address", field, i, cidr)
+		}
+	}
+	return nil
+}
+
+// HealthCheckOptions the probe of the local service, the server routes no
+// request to the client while the probe fails
+type HealthCheckOptions struct {
+	// Type the kind of probe, one of http, tcp and command, the local
+	// service is not probed if empty
+	Type string `yaml:"type,omitempty" json:"type,omitempty"`
+
+	// Path the path requested by the http probe, a status below 400 is healthy
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+
+	// Command the shell command run by the command probe, the exit status 0 is healthy
+	Command string `yaml:"command,omitempty" json:"command,omitempty"`
+
+	// Interval the delay between two probes
+	Interval types.Duration `yaml:"interval,omitempty" json:"interval,omitempty"`
+
+	// Timeout the maximum duration of a probe
+	Timeout types.Duration `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+
+	// HealthyThreshold the number of consecutive successes making an unhealthy service healthy
+	HealthyThreshold int `yaml:"healthy_threshold,omitempty" json:"healthy_threshold,omitempty"`
+
+	// UnhealthyThreshold the number of consecutive failures making a healthy service unhealthy
+	UnhealthyThreshold int `yaml:"unhealthy_threshold,omitempty" json:"unhealthy_threshold,omitempty"`
+}
+
+// SetDefaults sets the default values.
+func (o *HealthCheckOptions) SetDefaults() {
+	o.Path = "/"
+	o.Interval = types.Duration(time.Second * 10)
+	o.Timeout = types.Duration(time.Second * 3)
+	o.HealthyThreshold = 2
+	o.UnhealthyThreshold = 3
+}
+
+// AddFlags add health check related command line parameters
+func (o *HealthCheckOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Type, "health-check.type", o.Type, "The kind of probe of the local service, one of "+
+		"http, tcp and command, the local service is not probed if empty")
+
+	fs.StringVar(&o.Path, "health-check.path", o.Path, "The path requested by the http probe, a status "+
+		"below 400 is healthy")
+
+	fs.StringVar(&o.Command, "health-check.command", o.Command, "The shell command run by the command "+
+		"probe, the exit status 0 is healthy")
+
+	fs.Var(&o.Interval, "health-check.interval", "The delay between two probes")
+
+	fs.Var(&o.Timeout, "health-check.timeout", "The maximum duration of a probe")
+
+	fs.IntVar(&o.HealthyThreshold, "health-check.healthy-threshold", o.HealthyThreshold, "The number of "+
+		"consecutive successes making an unhealthy service healthy")
+
+	fs.IntVar(&o.UnhealthyThreshold, "health-check.unhealthy-threshold", o.UnhealthyThreshold, "The number "+
+		"of consecutive failures making a healthy service unhealthy")
+}
+
+// Validate verify the configuration and return an error if correct
+func (o *HealthCheckOptions) Validate() error {
+	switch o.Type {
+	case "":
+		return nil
+	case "http":
+		if !strings.HasPrefix(o.Path, "/") {
+			return fmt.Errorf("path must start with /")
+		}
+	case "tcp":
+	case "command":
+		if o.Command == "" {
+			return fmt.Errorf("command is required field")
+		}
+	default:
+		return fmt.Errorf("%s is an unknown type of health check", o.Type)
+	}
+
+	if o.Interval <= 0 || o.Timeout <= 0 {
+		return fmt.Errorf("interval and timeout must be greater than 0")
+	}
+
+	if o.HealthyThreshold < 1 || o.UnhealthyThreshold < 1 {
+		return fmt.Errorf("healthy_threshold and unhealthy_threshold must be at least 1")
+	}
+	return nil
+}
+
+// Configuration Profile contents
+type Configuration struct {
+	Log         *log.Options        `yaml:"log,omitempty" json:"log,omitempty"`
+	Client      *ClientOptions      `yaml:"client,omitempty" json:"client,omitempty"`
+	HealthCheck *HealthCheckOptions `yaml:"health_check,omitempty" json:"health_check,omitempty"`
+	Tunnel      *tunnel.Options     `yaml:"tunnel,omitempty" json:"tunnel,omitempty"`
+}
+
+// AddFlags   added the configuration  flag to the  specified pflag.FlagSet
+func (c *Configuration) AddFlags(fs *pflag.FlagSet) {
+	c.Log.AddFlags(fs)
+	c.Client.AddFlags(fs)
+	c.HealthCheck.AddFlags(fs)
+	c.Tunnel.AddFlags(fs)
+}
+
+// SetDefaults sets the default values.
+func (c *Configuration) SetDefaults() {
+	c.Log.SetDefaults()
+	c.Client.SetDefaults()
+	c.HealthCheck.SetDefaults()
+	c.Tunnel.SetDefaults()
+}
+
+// Validate Verify that the data in the Configuration meets the requirements
+func (c *Configuration) Validate() error {
+	errs := make([]error, 0)
+
+	if err := c.Log.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("log: %w", err))
+	}
+
+	if err := c.Client.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("client: %w", err))
+	}
+
+	if err := c.HealthCheck.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("health_check: %w", err))
+	}
+
+	if err := c.Tunnel.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("tunnel: %w", err))
+	}
+
+	return errors.NewAggregate(errs)
+}
+
+// NewConfiguration create Configuration with `zero` value
+func NewConfiguration() *Configuration {
+	return &Configuration{
+		Log:         log.NewOptions(),
+		Client:      new(ClientOptions),
+		HealthCheck: new(HealthCheckOptions),
+		Tunnel:      tunnel.NewOptions(),
+	}
+}
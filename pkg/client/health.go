@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/aapelismith/kun/pkg/client/config"
+	"net"
+	"net/http"
+	"os/exec"
+	"time"
+)
+
+// HealthChecker probes the local service at every interval. The service
+// becomes unhealthy after UnhealthyThreshold consecutive failures, and
+// healthy again after HealthyThreshold consecutive successes.
+type HealthChecker struct {
+	opts      *config.HealthCheckOptions
+	localAddr string
+	client    *http.Client
+}
+
+// Check probes the local service once, it returns the reason of the failure
+func (h *HealthChecker) Check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(h.opts.Timeout))
+	defer cancel()
+
+	switch h.opts.Type {
+	case "http":
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+h.localAddr+h.opts.Path, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := h.client.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("GET %s returned %s", h.opts.Path, resp.Status)
+		}
+		return nil
+	case "tcp":
+		var dialer net.Dialer
+
+		conn, err := dialer.DialContext(ctx, "tcp", h.localAddr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case "command":
+		out, err := exec.CommandContext(ctx, "sh", "-c", h.opts.Command).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("%v: %s", err, truncate(string(out), 200))
+		}
+		return nil
+	}
+	return fmt.Errorf("%s is an unknown type of health check", h.opts.Type)
+}
+
+// Run probes the local service until ctx is done, report is called with
+// the reason of the failure whenever the service changes its health
+func (h *HealthChecker) Run(ctx context.Context, report func(healthy bool, reason string)) {
+	ticker := time.NewTicker(time.Duration(h.opts.Interval))
+	defer ticker.Stop()
+
+	healthy, successes, failures := true, 0, 0
+
+	for {
+		if err := h.Check(ctx); err != nil {
+			successes, failures = 0, failures+1
+			if healthy && failures >= h.opts.UnhealthyThreshold {
+				healthy = false
+				report(false, err.Error())
+			}
+		} else {
+			successes, failures = successes+1, 0
+			if !healthy && successes >= h.opts.HealthyThreshold {
+				healthy = true
+				report(true, "")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// truncate cuts s down to n bytes
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// NewHealthChecker create HealthChecker probing the local service at localAddr,
+// nil is returned if opts enables no probe
+func NewHealthChecker(opts *config.HealthCheckOptions, localAddr string) *HealthChecker {
+	if opts == nil || opts.Type == "" {
+		return nil
+	}
+
+	return &HealthChecker{
+		opts:      opts,
+		localAddr: localAddr,
+		client: &http.Client{
+			// a redirect is a healthy answer by itself
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
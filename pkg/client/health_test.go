@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"context"
+	"github.com/aapelismith/kun/pkg/client"
+	"github.com/aapelismith/kun/pkg/client/config"
+	"github.com/aapelismith/kun/pkg/types"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newHealthCheckOptions(kind string) *config.HealthCheckOptions {
+	opts := new(config.HealthCheckOptions)
+	opts.SetDefaults()
+	opts.Type = kind
+	opts.Interval = types.Duration(time.Millisecond * 10)
+	opts.Timeout = types.Duration(time.Second)
+	return opts
+}
+
+func TestHealthChecker_Check(t *testing.T) {
+	ctx := context.Background()
+
+	var code int32 = http.StatusOK
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(int(atomic.LoadInt32(&code)))
+	}))
+	defer local.Close()
+
+	addr := local.Listener.Addr().String()
+
+	opts := newHealthCheckOptions("http")
+	opts.Path = "/healthz"
+	if err := opts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	h := client.NewHealthChecker(opts, addr)
+	if err := h.Check(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	atomic.StoreInt32(&code, http.StatusServiceUnavailable)
+	if err := h.Check(ctx); err == nil {
+		t.Fatal("expected the http probe to fail on 503")
+	}
+
+	if err := client.NewHealthChecker(newHealthCheckOptions("tcp"), addr).Check(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := l.Addr().String()
+	_ = l.Close()
+
+	if err := client.NewHealthChecker(newHealthCheckOptions("tcp"), closed).Check(ctx); err == nil {
+		t.Fatal("expected the tcp probe to fail on a closed port")
+	}
+
+	command := newHealthCheckOptions("command")
+	for script, ok := range map[string]bool{"exit 0": true, "echo broken; exit 1": false} {
+		command.Command = script
+		if err := client.NewHealthChecker(command, addr).Check(ctx); (err == nil) != ok {
+			t.Fatalf("unexpected result of %q, got: %v", script, err)
+		}
+	}
+
+	if client.NewHealthChecker(newHealthCheckOptions(""), addr) != nil {
+		t.Fatal("expected no health checker without a type")
+	}
+}
+
+func TestHealthChecker_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var healthy int32 = 1
+	local := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer local.Close()
+
+	opts := newHealthCheckOptions("http")
+	opts.HealthyThreshold, opts.UnhealthyThreshold = 2, 3
+
+	reports := make(chan bool, 4)
+	go client.NewHealthChecker(opts, local.Listener.Addr().String()).Run(ctx, func(ok bool, reason string) {
+		reports <- ok
+	})
+
+	select {
+	case ok := <-reports:
+		t.Fatalf("unexpected report %v of a healthy service", ok)
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	atomic.StoreInt32(&healthy, 0)
+
+	for _, expected := range []bool{false, true} {
+		select {
+		case ok := <-reports:
+			if ok != expected {
+				t.Fatalf("expected healthy %v, got %v", expected, ok)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected healthy %v reported", expected)
+		}
+		atomic.StoreInt32(&healthy, 1)
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"net"
+)
+
+// pipeDatagrams copies the datagrams between the tunnel dc and the
+// connected udp socket local until either fails, then closes both
+func pipeDatagrams(dc *tunnel.DatagramConn, local net.Conn) {
+	defer func() {
+		_ = dc.Close()
+		_ = local.Close()
+	}()
+
+	go func() {
+		defer func() {
+			_ = dc.Close()
+		}()
+
+		buf := make([]byte, tunnel.MaxDatagramSize)
+		for {
+			n, err := local.Read(buf)
+			if err != nil {
+				return
+			}
+
+			if err := dc.WriteDatagram(buf[:n]); err != nil {
+				return
+			}
+		}
+	}()
+
+	buf := make([]byte, tunnel.MaxDatagramSize)
+	for {
+		n, err := dc.ReadDatagram(buf)
+		if err != nil {
+			return
+		}
+
+		if _, err := local.Write(buf[:n]); err != nil {
+			return
+		}
+	}
+}
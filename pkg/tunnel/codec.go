@@ -0,0 +1,272 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"io"
+	"math"
+	"mime"
+	"strings"
+	"sync"
+)
+
+const (
+	// CodecGzip compresses the payload with gzip
+	CodecGzip = "gzip"
+	// CodecZstd compresses the payload with zstandard
+	CodecZstd = "zstd"
+	// CodecSnappy compresses the payload with the snappy block format
+	CodecSnappy = "snappy"
+)
+
+const (
+	// minCompressSize the payload shorter than it is never compressed
+	minCompressSize = 256
+
+	// maxEntropy the payload whose bytes carry more bits of information
+	// is taken for compressed or encrypted already, text carries about 5
+	maxEntropy = 7.5
+
+	// entropySamples the number of bytes of a payload its entropy is
+	// estimated from, a shorter payload is tried out instead
+	entropySamples = 1024
+)
+
+// ErrPayloadTooLarge the payload decompresses to more bytes than allowed
+var ErrPayloadTooLarge = errors.New("decompressed payload too large")
+
+// Codec compresses the payload of PUSH, the codec of a compressed payload
+// is named by the encoding of its message
+type Codec interface {
+	// Name the encoding of the messages compressed by the codec
+	Name() string
+
+	// Compress returns the compressed src
+	Compress(src []byte) ([]byte, error)
+
+	// Decompress returns the decompressed src, ErrPayloadTooLarge if it
+	// is longer than limit bytes
+	Decompress(src []byte, limit int) ([]byte, error)
+}
+
+var codecs = map[string]Codec{
+	CodecGzip:   &gzipCodec{},
+	CodecZstd:   &zstdCodec{},
+	CodecSnappy: snappyCodec{},
+}
+
+// GetCodec returns the codec of the encoding name
+func GetCodec(name string) (Codec, bool) {
+	c, ok := codecs[name]
+	return c, ok
+}
+
+// NegotiateCodec returns the first codec of offered which is supported, in
+// the order of preference of the peer, an empty string if there is none
+func NegotiateCodec(offered []string) string {
+	for _, name := range offered {
+		if _, ok := codecs[name]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// Compressible reports whether a body of contentType is worth compressing,
+// the images, audios, videos, fonts and archives are compressed already
+func Compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return true
+	}
+
+	typ, subtype, _ := strings.Cut(mediaType, "/")
+
+	switch typ {
+	case "image":
+		return subtype == "svg+xml" || subtype == "bmp" || subtype == "x-icon"
+	case "audio", "video":
+		return subtype == "wav" || subtype == "x-wav"
+	case "font":
+		return subtype != "woff" && subtype != "woff2"
+	case "application":
+		switch subtype {
+		case "zip", "gzip", "x-gzip", "zstd", "x-bzip2", "x-xz", "x-7z-compressed",
+			"x-rar-compressed", "vnd.rar", "java-archive", "pdf":
+			return false
+		}
+	}
+	return true
+}
+
+// incompressible reports whether payload looks compressed already by the
+// entropy of its bytes sampled evenly, whatever protocol it is the bytes of
+func incompressible(payload []byte) bool {
+	if len(payload) < entropySamples {
+		return false
+	}
+
+	var counts [256]int
+
+	n, stride := 0, len(payload)/entropySamples
+	for i := 0; i < len(payload); i += stride {
+		counts[payload[i]]++
+		n++
+	}
+
+	entropy := 0.0
+	for _, count := range counts {
+		if count != 0 {
+			p := float64(count) / float64(n)
+			entropy -= p * math.Log2(p)
+		}
+	}
+	return entropy > maxEntropy
+}
+
+// readLimited reads r to the end, ErrPayloadTooLarge if it is longer than limit bytes
+func readLimited(r io.Reader, limit int) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > limit {
+		return nil, ErrPayloadTooLarge
+	}
+	return data, nil
+}
+
+// gzipCodec the Codec of CodecGzip
+type gzipCodec struct {
+	writers sync.Pool
+	readers sync.Pool
+}
+
+// Name implements Codec
+func (g *gzipCodec) Name() string {
+	return CodecGzip
+}
+
+// Compress implements Codec
+func (g *gzipCodec) Compress(src []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w, ok := g.writers.Get().(*gzip.Writer)
+	if ok {
+		w.Reset(&buf)
+	} else {
+		w = gzip.NewWriter(&buf)
+	}
+	defer g.writers.Put(w)
+
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress implements Codec
+func (g *gzipCodec) Decompress(src []byte, limit int) ([]byte, error) {
+	r, ok := g.readers.Get().(*gzip.Reader)
+	if !ok {
+		r = new(gzip.Reader)
+	}
+	defer g.readers.Put(r)
+
+	if err := r.Reset(bytes.NewReader(src)); err != nil {
+		return nil, err
+	}
+	return readLimited(r, limit)
+}
+
+// zstdCodec the Codec of CodecZstd
+type zstdCodec struct {
+	once    sync.Once
+	encoder *zstd.Encoder
+	err     error
+	readers sync.Pool
+}
+
+// Name implements Codec
+func (z *zstdCodec) Name() string {
+	return CodecZstd
+}
+
+// Compress implements Codec
+func (z *zstdCodec) Compress(src []byte) ([]byte, error) {
+	z.once.Do(func() {
+		z.encoder, z.err = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	})
+
+	if z.err != nil {
+		return nil, z.err
+	}
+	return z.encoder.EncodeAll(src, nil), nil
+}
+
+// Decompress implements Codec
+func (z *zstdCodec) Decompress(src []byte, limit int) ([]byte, error) {
+	d, ok := z.readers.Get().(*zstd.Decoder)
+	if !ok {
+		var err error
+
+		d, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer z.readers.Put(d)
+
+	if err := d.Reset(bytes.NewReader(src)); err != nil {
+		return nil, err
+	}
+	return readLimited(d, limit)
+}
+
+// snappyCodec the Codec of CodecSnappy
+type snappyCodec struct{}
+
+// Name implements Codec
+func (snappyCodec) Name() string {
+	return CodecSnappy
+}
+
+// Compress implements Codec
+func (snappyCodec) Compress(src []byte) ([]byte, error) {
+	return snappy.Encode(nil, src), nil
+}
+
+// Decompress implements Codec
+func (snappyCodec) Decompress(src []byte, limit int) ([]byte, error) {
+	n, err := snappy.DecodedLen(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if n > limit {
+		return nil, fmt.Errorf("%w: %d bytes", ErrPayloadTooLarge, n)
+	}
+	return snappy.Decode(nil, src)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"testing"
+)
+
+func TestCodec(t *testing.T) {
+	data := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog "), 256)
+
+	for _, name := range []string{tunnel.CodecGzip, tunnel.CodecZstd, tunnel.CodecSnappy} {
+		codec, ok := tunnel.GetCodec(name)
+		if !ok {
+			t.Fatalf("codec %s is not supported", name)
+		}
+
+		if codec.Name() != name {
+			t.Fatalf("unexpected name %s of codec %s", codec.Name(), name)
+		}
+
+		compressed, err := codec.Compress(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(compressed) >= len(data) {
+			t.Fatalf("%s compressed %d bytes to %d bytes", name, len(data), len(compressed))
+		}
+
+		decompressed, err := codec.Decompress(compressed, len(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("%s decompressed unexpected data", name)
+		}
+
+		if _, err := codec.Decompress(compressed, len(data)-1); !errors.Is(err, tunnel.ErrPayloadTooLarge) {
+			t.Fatalf("expected ErrPayloadTooLarge from %s, got %v", name, err)
+		}
+
+		if _, err := codec.Decompress([]byte("garbage"), len(data)); err == nil {
+			t.Fatalf("expected an error from %s for corrupted payload", name)
+		}
+	}
+
+	if _, ok := tunnel.GetCodec("brotli"); ok {
+		t.Fatal("unexpected codec brotli")
+	}
+}
+
+func TestNegotiateCodec(t *testing.T) {
+	cases := []struct {
+		offered  []string
+		expected string
+	}{
+		{nil, ""},
+		{[]string{"brotli"}, ""},
+		{[]string{"brotli", "snappy", "zstd"}, "snappy"},
+		{[]string{"zstd", "gzip"}, "zstd"},
+	}
+
+	for _, c := range cases {
+		if codec := tunnel.NegotiateCodec(c.offered); codec != c.expected {
+			t.Fatalf("expected %q negotiated out of %v, got %q", c.expected, c.offered, codec)
+		}
+	}
+}
+
+func TestCompressible(t *testing.T) {
+	cases := map[string]bool{
+		"":                         true,
+		"text/html; charset=utf-8": true,
+		"application/json":         true,
+		"image/svg+xml":            true,
+		"image/png":                false,
+		"IMAGE/JPEG":               false,
+		"video/mp4":                false,
+		"font/woff2":               false,
+		"application/zip":          false,
+		"application/gzip":         false,
+		"application/octet-stream": true,
+		"multipart/form-data; b=1": true,
+	}
+
+	for contentType, expected := range cases {
+		if tunnel.Compressible(contentType) != expected {
+			t.Fatalf("expected compressible %v for %q", expected, contentType)
+		}
+	}
+}
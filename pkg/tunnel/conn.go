@@ -0,0 +1,731 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"io"
+	"math"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// TokenKey the metadata key carrying the tunnel token of ConnectTunnel
+const TokenKey = "tunnel-token"
+
+// ErrTimeout nothing was received from the peer within the keepalive timeout
+var ErrTimeout = errors.New("tunnel keepalive timeout")
+
+var (
+	_ net.Conn = (*Conn)(nil)
+	_ Stream   = (v1.BackendController_ConnectTunnelServer)(nil)
+	_ Stream   = (v1.BackendController_ConnectTunnelClient)(nil)
+)
+
+// Stream the ConnectTunnel stream seen from the server or the client
+type Stream interface {
+	Context() context.Context
+	Send(*v1.TunnelMessage) error
+	Recv() (*v1.TunnelMessage, error)
+}
+
+// Limiter throttles the bytes moved through a tunnel, WaitN blocks until n
+// more bytes are allowed or ctx is done
+type Limiter interface {
+	WaitN(ctx context.Context, n int) error
+}
+
+// Addr the address of a tunnel endpoint
+type Addr string
+
+// Network implements net.Addr
+func (a Addr) Network() string {
+	return "tunnel"
+}
+
+// String implements net.Addr
+func (a Addr) String() string {
+	return string(a)
+}
+
+// Conn implements net.Conn on top of a ConnectTunnel stream. Bytes are
+// moved with PUSH, CloseWrite half-closes the tunnel with FINISH and Close
+// aborts it with RESET unless both sides have finished. The peer is probed
+// with PING when it is silent for too long, see Options.
+//
+// The bytes pushed are limited by credits: either side may push
+// InitialWindowSize bytes at first, then the reader grants the bytes it
+// consumed back to the peer with WINDOW. The received bytes waiting for
+// Read never exceed Options.WindowSize, a slow reader blocks the Write of
+// the peer instead.
+type Conn struct {
+	stream Stream
+	opts   *Options
+	addr   Addr
+
+	mu         sync.Mutex
+	state      State
+	err        error
+	terminated bool
+
+	// sendWindow the number of bytes the peer allows us to push
+	sendWindow int64
+	// recvWindow the number of bytes we allow the peer to push
+	recvWindow int64
+
+	// received the payload waiting for Read
+	received [][]byte
+	// consumed the number of bytes read since the last WINDOW sent
+	consumed int
+	// finished is true once the peer sent FINISH
+	finished bool
+
+	// codec compresses the payload pushed unless compress is false
+	codec    Codec
+	compress bool
+
+	// limiter throttles the bytes pushed and read, nil if unlimited
+	limiter Limiter
+
+	// sendMu serializes the calls of stream.Send
+	sendMu sync.Mutex
+
+	readMu sync.Mutex
+
+	readable chan struct{}
+	writable chan struct{}
+	done     chan struct{}
+	opened   chan struct{}
+	pong     chan struct{}
+
+	// lastRecv the unix nano time of the last received message
+	lastRecv int64
+	// probing is 1 while a PING or PONG of the keepalive is being sent
+	probing int32
+
+	readDeadline  *deadline
+	writeDeadline *deadline
+}
+
+// Done is closed when the tunnel is closed or reset
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Opened is closed when the first PUSH is sent or received
+func (c *Conn) Opened() <-chan struct{} {
+	return c.opened
+}
+
+// State returns the current state of the tunnel
+func (c *Conn) State() State {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.state
+}
+
+// Err returns the reason why the tunnel terminated, nil if it is alive
+// or both sides finished gracefully
+func (c *Conn) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.err
+}
+
+// Open opens an idle tunnel with an empty PUSH
+func (c *Conn) Open() error {
+	if c.State() != StateIdle {
+		return nil
+	}
+	return c.send(CommandPush, nil)
+}
+
+// Read implements net.Conn, what was received before the tunnel
+// terminated is delivered first
+func (c *Conn) Read(b []byte) (int, error) {
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+
+	for {
+		c.mu.Lock()
+		if len(c.received) > 0 {
+			n := 0
+			for n < len(b) && len(c.received) > 0 {
+				m := copy(b[n:], c.received[0])
+				if m == len(c.received[0]) {
+					c.received = c.received[1:]
+				} else {
+					c.received[0] = c.received[0][m:]
+				}
+				n += m
+			}
+
+			credit := c.consumeLocked(n)
+			c.mu.Unlock()
+
+			// the credits are granted once the bytes are allowed, so that a
+			// throttled reader slows the peer down
+			_ = c.throttle(n)
+
+			if credit > 0 {
+				_ = c.grant(credit)
+			}
+			return n, nil
+		}
+
+		finished, terminated, err := c.finished, c.terminated, c.err
+		c.mu.Unlock()
+
+		if finished || (terminated && err == nil) {
+			return 0, io.EOF
+		}
+
+		if terminated {
+			return 0, err
+		}
+
+		select {
+		case <-c.readable:
+		case <-c.done:
+		case <-c.readDeadline.wait():
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+}
+
+// Write implements net.Conn, it blocks while the peer allows no more bytes
+func (c *Conn) Write(b []byte) (int, error) {
+	n := 0
+	for len(b) > 0 {
+		size := len(b)
+		if size > c.opts.MaxPayloadSize {
+			size = c.opts.MaxPayloadSize
+		}
+
+		size, err := c.reserve(size)
+		if err != nil {
+			return n, err
+		}
+
+		if err := c.throttle(size); err != nil {
+			return n, err
+		}
+
+		// b must not be retained, the stream may hold the payload after Send
+		payload := make([]byte, size)
+		copy(payload, b)
+
+		if err := c.sendMessage(c.encode(payload)); err != nil {
+			return n, err
+		}
+
+		n += size
+		b = b[size:]
+	}
+	return n, nil
+}
+
+// SetCodec compresses the payload pushed with codec where it helps, nil
+// disables the compression. The payload received is decompressed with the
+// codec named by its message whatever codec is set.
+func (c *Conn) SetCodec(codec Codec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.codec = codec
+}
+
+// SetLimiter throttles the bytes pushed and read with limiter, nil removes the limit
+func (c *Conn) SetLimiter(limiter Limiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.limiter = limiter
+}
+
+// throttle waits until the limiter allows n more bytes, or the stream ends
+func (c *Conn) throttle(n int) error {
+	c.mu.Lock()
+	limiter := c.limiter
+	c.mu.Unlock()
+
+	if limiter == nil || n == 0 {
+		return nil
+	}
+	return limiter.WaitN(c.stream.Context(), n)
+}
+
+// SetCompress suspends the compression of the payload pushed if compress
+// is false, e.g. while a body which is compressed already is written
+func (c *Conn) SetCompress(compress bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.compress = compress
+}
+
+// encode returns the PUSH of payload, which is compressed if it gets shorter.
+// A payload which looks compressed already, e.g. the bytes of an image or a
+// gzip body, is not tried.
+func (c *Conn) encode(payload []byte) *v1.TunnelMessage {
+	msg := &v1.TunnelMessage{Command: CommandPush, Payload: payload}
+
+	c.mu.Lock()
+	codec, compress := c.codec, c.compress
+	c.mu.Unlock()
+
+	if codec == nil || !compress || len(payload) < minCompressSize || incompressible(payload) {
+		return msg
+	}
+
+	if data, err := codec.Compress(payload); err == nil && len(data) < len(payload) {
+		msg.Payload, msg.Encoding = data, codec.Name()
+	}
+	return msg
+}
+
+// decode decompresses the payload of msg in place, the payload may not
+// exceed the window
+func (c *Conn) decode(msg *v1.TunnelMessage) error {
+	codec, ok := GetCodec(msg.Encoding)
+	if !ok {
+		return fmt.Errorf("%w: unknown encoding %q", ErrProtocol, msg.Encoding)
+	}
+
+	payload, err := codec.Decompress(msg.Payload, c.opts.WindowSize)
+	if err != nil {
+		return fmt.Errorf("%w: %s payload: %v", ErrProtocol, msg.Encoding, err)
+	}
+
+	msg.Payload, msg.Encoding = payload, ""
+	return nil
+}
+
+// reserve waits until the peer allows us to push bytes, then takes up to
+// size bytes out of the send window
+func (c *Conn) reserve(size int) (int, error) {
+	for {
+		c.mu.Lock()
+		if c.terminated {
+			err := c.err
+			c.mu.Unlock()
+
+			if err == nil {
+				err = ErrClosed
+			}
+			return 0, err
+		}
+
+		if _, err := c.state.Next(Outbound, CommandPush); err != nil {
+			c.mu.Unlock()
+			return 0, err
+		}
+
+		if c.sendWindow > 0 {
+			if int64(size) > c.sendWindow {
+				size = int(c.sendWindow)
+			}
+			c.sendWindow -= int64(size)
+
+			// another writer may use the rest of the window
+			if c.sendWindow > 0 {
+				signal(c.writable)
+			}
+			c.mu.Unlock()
+			return size, nil
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-c.writable:
+		case <-c.done:
+		case <-c.writeDeadline.wait():
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+}
+
+// consumeLocked records n bytes read and returns the credit to grant back
+// to the peer, once half of the window is consumed, must be called with c.mu held
+func (c *Conn) consumeLocked(n int) int {
+	c.consumed += n
+
+	if c.finished || c.consumed < c.opts.WindowSize/2 {
+		return 0
+	}
+
+	credit := c.consumed
+	c.consumed = 0
+	return credit
+}
+
+// grant allows the peer to push n bytes more
+func (c *Conn) grant(n int) error {
+	c.mu.Lock()
+	c.recvWindow += int64(n)
+	c.mu.Unlock()
+
+	return c.sendMessage(&v1.TunnelMessage{Command: CommandWindow, Window: uint32(n)})
+}
+
+// grantWindow raises the window of the peer from InitialWindowSize to
+// Options.WindowSize once the tunnel is opened
+func (c *Conn) grantWindow() {
+	if n := c.opts.WindowSize - InitialWindowSize; n > 0 {
+		_ = c.grant(n)
+	}
+}
+
+// CloseWrite half-closes the tunnel with FINISH
+func (c *Conn) CloseWrite() error {
+	return c.send(CommandFinish, nil)
+}
+
+// Close implements net.Conn
+func (c *Conn) Close() error {
+	c.terminate(net.ErrClosed, true)
+	return nil
+}
+
+// LocalAddr implements net.Conn
+func (c *Conn) LocalAddr() net.Addr {
+	return c.addr
+}
+
+// RemoteAddr implements net.Conn
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// SetDeadline implements net.Conn
+func (c *Conn) SetDeadline(t time.Time) error {
+	c.readDeadline.set(t)
+	c.writeDeadline.set(t)
+	return nil
+}
+
+// SetReadDeadline implements net.Conn
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	c.readDeadline.set(t)
+	return nil
+}
+
+// SetWriteDeadline implements net.Conn, a write waiting for the window of
+// the peer is interrupted, a write blocked by the stream is not, the
+// deadline is checked before every PUSH
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	c.writeDeadline.set(t)
+	return nil
+}
+
+// send sends a message after checking it is legal in the current state
+func (c *Conn) send(command string, payload []byte) error {
+	return c.sendMessage(&v1.TunnelMessage{Command: command, Payload: payload})
+}
+
+// sendMessage sends msg after checking it is legal in the current state,
+// the window of the peer is raised if msg opens the tunnel
+func (c *Conn) sendMessage(msg *v1.TunnelMessage) error {
+	opened, err := c.sendLocked(msg)
+	if opened {
+		c.grantWindow()
+	}
+	return err
+}
+
+// sendLocked sends msg with c.sendMu held, it reports whether msg opened the tunnel
+func (c *Conn) sendLocked(msg *v1.TunnelMessage) (bool, error) {
+	command := msg.Command
+
+	if command == CommandPush && c.writeDeadline.expired() {
+		return false, os.ErrDeadlineExceeded
+	}
+
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+
+	c.mu.Lock()
+	if c.terminated {
+		err := c.err
+		c.mu.Unlock()
+
+		if err == nil {
+			err = ErrClosed
+		}
+		return false, err
+	}
+
+	next, err := c.state.Next(Outbound, command)
+	if err != nil {
+		c.mu.Unlock()
+		return false, err
+	}
+
+	prev := c.state
+	c.state = next
+	c.mu.Unlock()
+
+	opened := prev == StateIdle && next != StateIdle && command == CommandPush
+	if opened {
+		close(c.opened)
+	}
+
+	if err := c.stream.Send(msg); err != nil {
+		c.terminate(fmt.Errorf("%w: %v", ErrReset, err), false)
+		return false, err
+	}
+
+	if next == StateClosed {
+		c.terminate(nil, false)
+	}
+	return opened, nil
+}
+
+// recvLoop receives the messages of the peer until the tunnel terminates
+func (c *Conn) recvLoop() {
+	for {
+		msg, err := c.stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				err = fmt.Errorf("%w: %v", ErrReset, err)
+			} else {
+				err = ErrReset
+			}
+			c.terminate(err, false)
+			return
+		}
+
+		atomic.StoreInt64(&c.lastRecv, time.Now().UnixNano())
+
+		if msg.Command == CommandPush && msg.Encoding != "" {
+			if err := c.decode(msg); err != nil {
+				c.terminate(err, true)
+				return
+			}
+		}
+
+		c.mu.Lock()
+		if c.terminated {
+			c.mu.Unlock()
+			return
+		}
+
+		prev := c.state
+		next, err := c.state.Next(Inbound, msg.Command)
+
+		switch {
+		case err != nil:
+		case msg.Command == CommandPush && int64(len(msg.Payload)) > c.recvWindow:
+			err = fmt.Errorf("%w: %d bytes pushed past the window", ErrProtocol,
+				int64(len(msg.Payload))-c.recvWindow)
+		case msg.Command == CommandWindow && c.sendWindow+int64(msg.Window) > math.MaxInt32:
+			err = fmt.Errorf("%w: window overflow", ErrProtocol)
+		}
+
+		if err != nil {
+			c.mu.Unlock()
+			c.terminate(err, true)
+			return
+		}
+
+		c.state = next
+
+		switch msg.Command {
+		case CommandPush:
+			if len(msg.Payload) > 0 {
+				c.recvWindow -= int64(len(msg.Payload))
+				c.received = append(c.received, msg.Payload)
+			}
+		case CommandFinish:
+			c.finished = true
+		case CommandWindow:
+			c.sendWindow += int64(msg.Window)
+		}
+		c.mu.Unlock()
+
+		switch msg.Command {
+		case CommandPing:
+			signal(c.pong)
+		case CommandPush:
+			if prev == StateIdle {
+				close(c.opened)
+				c.grantWindow()
+			}
+			signal(c.readable)
+		case CommandFinish:
+			signal(c.readable)
+
+			if next == StateClosed {
+				c.terminate(nil, false)
+				return
+			}
+		case CommandWindow:
+			signal(c.writable)
+		case CommandReset:
+			c.terminate(ErrReset, false)
+			return
+		}
+	}
+}
+
+// keepaliveLoop answers PING, probes the silent peer and resets the tunnel
+// when the peer stays silent past the keepalive timeout
+func (c *Conn) keepaliveLoop() {
+	interval := time.Duration(c.opts.KeepaliveInterval)
+	timeout := time.Duration(c.opts.KeepaliveTimeout)
+
+	tick := interval
+	if timeout < tick {
+		tick = timeout
+	}
+
+	ticker := time.NewTicker(tick / 2)
+	defer ticker.Stop()
+
+	var lastPing time.Time
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.pong:
+			c.probe(CommandPong)
+		case now := <-ticker.C:
+			silent := now.Sub(time.Unix(0, atomic.LoadInt64(&c.lastRecv)))
+
+			if silent >= interval+timeout {
+				c.terminate(ErrTimeout, true)
+				return
+			}
+
+			if silent >= interval && now.Sub(lastPing) >= interval {
+				c.probe(CommandPing)
+				lastPing = now
+			}
+		}
+	}
+}
+
+// probe sends the PING or PONG command in the background, so that a send
+// stuck on a peer which stopped reading does not keep the keepalive from
+// noticing it. It is skipped while the previous one is being sent.
+func (c *Conn) probe(command string) {
+	if !atomic.CompareAndSwapInt32(&c.probing, 0, 1) {
+		return
+	}
+
+	go func() {
+		defer atomic.StoreInt32(&c.probing, 0)
+		_ = c.send(command, nil)
+	}()
+}
+
+// pongLoop answers PING without probing the peer
+func (c *Conn) pongLoop() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.pong:
+			_ = c.send(CommandPong, nil)
+		}
+	}
+}
+
+// terminate ends the tunnel with err at once, sending RESET to the peer if
+// reset is true and the tunnel was not closed gracefully. The RESET is sent
+// in the background once the message being sent is, a peer which stopped
+// reading must not hold up Done, the owner cancels the stream then.
+func (c *Conn) terminate(err error, reset bool) {
+	c.mu.Lock()
+	if c.terminated {
+		c.mu.Unlock()
+		return
+	}
+
+	c.terminated = true
+
+	if c.state == StateClosed {
+		reset = false
+	} else {
+		c.state = StateReset
+		c.err = err
+	}
+	c.mu.Unlock()
+
+	close(c.done)
+
+	if reset {
+		go func() {
+			c.sendMu.Lock()
+			defer c.sendMu.Unlock()
+
+			_ = c.stream.Send(&v1.TunnelMessage{Command: CommandReset})
+		}()
+	}
+}
+
+// NewConn create Conn on top of stream and start serving it, the stream
+// should be canceled by its owner once the Conn is done
+func NewConn(stream Stream, opts *Options, addr string) *Conn {
+	return newConn(stream, opts, addr, true)
+}
+
+// newConn create Conn on top of stream, probing the silent peer if keepalive
+// is true. The logical streams of a Session are not probed, the Session probes
+// the peer on behalf of all of them.
+func newConn(stream Stream, opts *Options, addr string, keepalive bool) *Conn {
+	c := &Conn{
+		stream:        stream,
+		opts:          opts,
+		addr:          Addr(addr),
+		state:         StateIdle,
+		sendWindow:    InitialWindowSize,
+		recvWindow:    InitialWindowSize,
+		compress:      true,
+		readable:      make(chan struct{}, 1),
+		writable:      make(chan struct{}, 1),
+		done:          make(chan struct{}),
+		opened:        make(chan struct{}),
+		pong:          make(chan struct{}, 1),
+		lastRecv:      time.Now().UnixNano(),
+		readDeadline:  newDeadline(),
+		writeDeadline: newDeadline(),
+	}
+
+	go c.recvLoop()
+
+	if keepalive {
+		go c.keepaliveLoop()
+	} else {
+		go c.pongLoop()
+	}
+	return c
+}
+
+// signal notifies the waiter of ch without blocking
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
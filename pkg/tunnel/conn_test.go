@@ -0,0 +1,620 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"github.com/aapelismith/kun/pkg/types"
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stream one side of an in-memory ConnectTunnel stream
+type stream struct {
+	ctx  context.Context
+	send chan<- *v1.TunnelMessage
+	recv <-chan *v1.TunnelMessage
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) Send(msg *v1.TunnelMessage) error {
+	select {
+	case s.send <- msg:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *stream) Recv() (*v1.TunnelMessage, error) {
+	select {
+	case msg := <-s.recv:
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func newStreamPair(t *testing.T) (*stream, *stream) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	a, b := make(chan *v1.TunnelMessage, 64), make(chan *v1.TunnelMessage, 64)
+	return &stream{ctx: ctx, send: a, recv: b}, &stream{ctx: ctx, send: b, recv: a}
+}
+
+// newStuckStream returns a stream whose peer neither reads nor sends, its
+// Send blocks until the test ends
+func newStuckStream(t *testing.T) *stream {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	return &stream{ctx: ctx, send: make(chan *v1.TunnelMessage), recv: make(chan *v1.TunnelMessage)}
+}
+
+func newOptions() *tunnel.Options {
+	opts := tunnel.NewOptions()
+	opts.SetDefaults()
+	opts.MaxPayloadSize = 4
+	return opts
+}
+
+func TestConn_Exchange(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	server := tunnel.NewConn(s1, newOptions(), "server")
+	client := tunnel.NewConn(s2, newOptions(), "client")
+
+	if err := server.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-client.Opened():
+	case <-time.After(time.Second):
+		t.Fatal("the client was not opened by the empty PUSH")
+	}
+
+	if _, err := server.Write([]byte("hello world")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := server.CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := io.ReadAll(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(data, []byte("hello world")) {
+		t.Fatalf("unexpected data %q", data)
+	}
+
+	// the client may still push bytes after the server finished
+	if _, err := client.Write([]byte("bye")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := server.Write([]byte("x")); !errors.Is(err, tunnel.ErrFinished) {
+		t.Fatalf("expected ErrFinished, got %v", err)
+	}
+
+	if err := client.CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err = io.ReadAll(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(data, []byte("bye")) {
+		t.Fatalf("unexpected data %q", data)
+	}
+
+	for _, c := range []*tunnel.Conn{server, client} {
+		select {
+		case <-c.Done():
+		case <-time.After(time.Second):
+			t.Fatal("the tunnel was not closed")
+		}
+
+		if c.State() != tunnel.StateClosed || c.Err() != nil {
+			t.Fatalf("expected a closed tunnel, got %s and %v", c.State(), c.Err())
+		}
+	}
+}
+
+func TestConn_Reset(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	server := tunnel.NewConn(s1, newOptions(), "server")
+	client := tunnel.NewConn(s2, newOptions(), "client")
+
+	if _, err := server.Write([]byte("hi")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-server.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the tunnel was not reset")
+	}
+
+	if _, err := server.Read(make([]byte, 1)); !errors.Is(err, tunnel.ErrReset) {
+		t.Fatalf("expected ErrReset, got %v", err)
+	}
+
+	if _, err := server.Write([]byte("hi")); !errors.Is(err, tunnel.ErrReset) {
+		t.Fatalf("expected ErrReset, got %v", err)
+	}
+}
+
+func TestConn_ProtocolViolation(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	server := tunnel.NewConn(s1, newOptions(), "server")
+
+	s2.send <- &v1.TunnelMessage{Command: tunnel.CommandFinish}
+	s2.send <- &v1.TunnelMessage{Command: tunnel.CommandPush, Payload: []byte("late")}
+
+	select {
+	case <-server.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the tunnel was not reset")
+	}
+
+	if err := server.Err(); !errors.Is(err, tunnel.ErrProtocol) {
+		t.Fatalf("expected ErrProtocol, got %v", err)
+	}
+
+	select {
+	case msg := <-s2.recv:
+		if msg.Command != tunnel.CommandReset {
+			t.Fatalf("expected RESET, got %s", msg.Command)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("RESET was not sent")
+	}
+}
+
+func TestConn_Keepalive(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	opts := newOptions()
+	opts.KeepaliveInterval = types.Duration(time.Millisecond * 20)
+	opts.KeepaliveTimeout = types.Duration(time.Millisecond * 40)
+
+	server := tunnel.NewConn(s1, opts, "server")
+
+	select {
+	case msg := <-s2.recv:
+		if msg.Command != tunnel.CommandPing {
+			t.Fatalf("expected PING, got %s", msg.Command)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("PING was not sent")
+	}
+
+	s2.send <- &v1.TunnelMessage{Command: tunnel.CommandPing}
+
+	for msg := range s2.recv {
+		if msg.Command == tunnel.CommandPong {
+			break
+		}
+	}
+
+	select {
+	case <-server.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the silent tunnel was not reset")
+	}
+
+	if err := server.Err(); !errors.Is(err, tunnel.ErrTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+}
+
+func TestConn_StuckPeer(t *testing.T) {
+	opts := newOptions()
+	opts.KeepaliveInterval = types.Duration(time.Millisecond * 20)
+	opts.KeepaliveTimeout = types.Duration(time.Millisecond * 40)
+
+	// the keepalive resets the tunnel while a PUSH is stuck
+	conn := tunnel.NewConn(newStuckStream(t), opts, "server")
+	go func() {
+		_, _ = conn.Write([]byte("ping"))
+	}()
+
+	select {
+	case <-conn.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the tunnel of the stuck peer was not reset")
+	}
+
+	if err := conn.Err(); !errors.Is(err, tunnel.ErrTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+
+	// so does Close
+	opts.KeepaliveInterval = types.Duration(time.Minute)
+
+	conn = tunnel.NewConn(newStuckStream(t), opts, "server")
+	go func() {
+		_, _ = conn.Write([]byte("ping"))
+	}()
+	time.Sleep(time.Millisecond * 20)
+
+	closed := make(chan struct{})
+	go func() {
+		_ = conn.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("Close blocked on the stuck peer")
+	}
+
+	select {
+	case <-conn.Done():
+	default:
+		t.Fatal("the tunnel is not done once closed")
+	}
+}
+
+func TestConn_FlowControl(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	opts := newOptions()
+	opts.MaxPayloadSize = 1024
+
+	server := tunnel.NewConn(s1, opts, "server")
+	client := tunnel.NewConn(s2, opts, "client")
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 64*1024)
+
+	var written int64
+	errCh := make(chan error, 1)
+
+	go func() {
+		for b := data; len(b) > 0; b = b[4096:] {
+			if _, err := server.Write(b[:4096]); err != nil {
+				errCh <- err
+				return
+			}
+			atomic.AddInt64(&written, 4096)
+		}
+		errCh <- server.CloseWrite()
+	}()
+
+	// the client reads nothing, the server must stop at the window
+	time.Sleep(time.Millisecond * 200)
+
+	if n := atomic.LoadInt64(&written); n > int64(opts.WindowSize) {
+		t.Fatalf("%d bytes written past the window of %d bytes", n, opts.WindowSize)
+	}
+
+	select {
+	case err := <-errCh:
+		t.Fatalf("the write ended without the reader: %v", err)
+	default:
+	}
+
+	received, err := io.ReadAll(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(received, data) {
+		t.Fatalf("received %d bytes, expected %d", len(received), len(data))
+	}
+}
+
+func TestConn_WindowSize(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	opts := newOptions()
+	opts.MaxPayloadSize = 1024
+
+	if size := opts.NegotiateWindowSize(0); size != opts.WindowSize {
+		t.Fatalf("expected the window of the server by default, got %d", size)
+	}
+
+	if size := opts.NegotiateWindowSize(1024); size != tunnel.InitialWindowSize {
+		t.Fatalf("expected the window raised to %d, got %d", tunnel.InitialWindowSize, size)
+	}
+
+	if size := opts.NegotiateWindowSize(math.MaxInt32); size != opts.MaxWindowSize {
+		t.Fatalf("expected the window bounded by %d, got %d", opts.MaxWindowSize, size)
+	}
+
+	// the client grants the larger window of its upstream
+	size := opts.NegotiateWindowSize(opts.WindowSize * 4)
+
+	server := tunnel.NewConn(s1, opts, "server")
+	client := tunnel.NewConn(s2, opts.WithWindowSize(size), "client")
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), size/16)
+
+	var written int64
+	go func() {
+		for b := data; len(b) > 0; b = b[4096:] {
+			if _, err := server.Write(b[:4096]); err != nil {
+				return
+			}
+			atomic.AddInt64(&written, 4096)
+		}
+	}()
+
+	// the client reads nothing, the server stops at the window of the client
+	time.Sleep(time.Millisecond * 200)
+
+	if n := atomic.LoadInt64(&written); n <= int64(opts.WindowSize) || n > int64(size) {
+		t.Fatalf("expected the window of %d bytes, %d bytes written", size, n)
+	}
+
+	_ = client.Close()
+}
+
+func TestConn_WindowViolation(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	server := tunnel.NewConn(s1, newOptions(), "server")
+
+	s2.send <- &v1.TunnelMessage{Command: tunnel.CommandPush, Payload: make([]byte, tunnel.InitialWindowSize+1)}
+
+	select {
+	case <-server.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the tunnel was not reset")
+	}
+
+	if err := server.Err(); !errors.Is(err, tunnel.ErrProtocol) {
+		t.Fatalf("expected ErrProtocol, got %v", err)
+	}
+}
+
+func TestConn_Compression(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	opts := newOptions()
+	opts.MaxPayloadSize = 4096
+
+	codec, _ := tunnel.GetCodec(tunnel.CodecZstd)
+
+	server := tunnel.NewConn(s1, opts, "server")
+	server.SetCodec(codec)
+
+	text := bytes.Repeat([]byte("compressible "), 512)[:4096]
+	random := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	push := func(data []byte) *v1.TunnelMessage {
+		if _, err := server.Write(data); err != nil {
+			t.Fatal(err)
+		}
+
+		for {
+			select {
+			case msg := <-s2.recv:
+				if msg.Command == tunnel.CommandPush {
+					return msg
+				}
+			case <-time.After(time.Second):
+				t.Fatal("PUSH was not sent")
+			}
+		}
+	}
+
+	if msg := push(text); msg.Encoding != tunnel.CodecZstd || len(msg.Payload) >= len(text) {
+		t.Fatalf("expected the text compressed, got %q encoding of %d bytes", msg.Encoding, len(msg.Payload))
+	}
+
+	if msg := push(random); msg.Encoding != "" || !bytes.Equal(msg.Payload, random) {
+		t.Fatalf("expected the random bytes sent as is, got %q encoding", msg.Encoding)
+	}
+
+	server.SetCompress(false)
+
+	if msg := push(text); msg.Encoding != "" {
+		t.Fatalf("expected the compression suspended, got %q encoding", msg.Encoding)
+	}
+
+	// the peer decompresses whatever its own codec
+	s3, s4 := newStreamPair(t)
+
+	server = tunnel.NewConn(s3, opts, "server")
+	server.SetCodec(codec)
+	client := tunnel.NewConn(s4, opts, "client")
+
+	data := append(append([]byte{}, text...), random...)
+
+	go func() {
+		_, _ = server.Write(data)
+		_ = server.CloseWrite()
+	}()
+
+	received, err := io.ReadAll(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(received, data) {
+		t.Fatalf("received %d unexpected bytes", len(received))
+	}
+
+	// a corrupted payload is a protocol violation
+	s5, s6 := newStreamPair(t)
+
+	server = tunnel.NewConn(s5, opts, "server")
+	s6.send <- &v1.TunnelMessage{Command: tunnel.CommandPush, Payload: []byte("garbage"), Encoding: tunnel.CodecGzip}
+
+	select {
+	case <-server.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the tunnel was not reset")
+	}
+
+	if err := server.Err(); !errors.Is(err, tunnel.ErrProtocol) {
+		t.Fatalf("expected ErrProtocol, got %v", err)
+	}
+}
+
+// countingCodec counts the payloads it is asked to compress
+type countingCodec struct {
+	tunnel.Codec
+	calls int64
+}
+
+func (c *countingCodec) Compress(src []byte) ([]byte, error) {
+	atomic.AddInt64(&c.calls, 1)
+	return c.Codec.Compress(src)
+}
+
+func TestConn_CompressedResponse(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	opts := newOptions()
+	opts.MaxPayloadSize = 32 * 1024
+
+	zstd, _ := tunnel.GetCodec(tunnel.CodecZstd)
+	codec := &countingCodec{Codec: zstd}
+
+	client := tunnel.NewConn(s1, opts, "client")
+	client.SetCodec(codec)
+
+	// a png of noise, its pixels are compressed by the encoder already
+	img := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	rand.New(rand.NewSource(1)).Read(img.Pix)
+
+	body := &bytes.Buffer{}
+	if err := png.Encode(body, img); err != nil {
+		t.Fatal(err)
+	}
+
+	response := []byte(fmt.Sprintf("HTTP/1.1 200 OK\r\nContent-Type: image/png\r\nContent-Length: %d\r\n\r\n", body.Len()))
+	response = append(response, body.Bytes()...)
+
+	go func() {
+		_, _ = client.Write(response)
+	}()
+
+	for {
+		select {
+		case msg := <-s2.recv:
+			if msg.Command != tunnel.CommandPush {
+				continue
+			}
+
+			if msg.Encoding != "" || !bytes.Equal(msg.Payload, response) {
+				t.Fatalf("expected the image/png response sent uncompressed, got %q encoding", msg.Encoding)
+			}
+
+			if calls := atomic.LoadInt64(&codec.calls); calls != 0 {
+				t.Fatalf("expected no compression tried, got %d", calls)
+			}
+			return
+		case <-time.After(time.Second):
+			t.Fatal("PUSH was not sent")
+		}
+	}
+}
+
+// countingLimiter records the bytes it allowed and refuses them once ctx is done
+type countingLimiter struct {
+	bytes int64
+	calls int64
+}
+
+func (l *countingLimiter) WaitN(ctx context.Context, n int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	atomic.AddInt64(&l.bytes, int64(n))
+	atomic.AddInt64(&l.calls, 1)
+	return nil
+}
+
+func TestConn_Limiter(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	server := tunnel.NewConn(s1, newOptions(), "server")
+	client := tunnel.NewConn(s2, newOptions(), "client")
+
+	written, read := &countingLimiter{}, &countingLimiter{}
+	server.SetLimiter(written)
+	client.SetLimiter(read)
+
+	go func() {
+		_, _ = server.Write([]byte("hello world"))
+		_ = server.CloseWrite()
+	}()
+
+	data, err := io.ReadAll(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "hello world" {
+		t.Fatalf("unexpected data %q", data)
+	}
+
+	// the payload of every PUSH is at most 4 bytes
+	if atomic.LoadInt64(&written.bytes) != 11 || atomic.LoadInt64(&written.calls) != 3 {
+		t.Fatalf("expected 11 bytes written in 3 pushes, got %d in %d", written.bytes, written.calls)
+	}
+
+	if atomic.LoadInt64(&read.bytes) != 11 {
+		t.Fatalf("expected 11 bytes read, got %d", read.bytes)
+	}
+
+	// the write fails once the stream ends while waiting for the limiter
+	s3, _ := newStreamPair(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	s3.ctx = ctx
+
+	conn := tunnel.NewConn(s3, newOptions(), "server")
+	conn.SetLimiter(&countingLimiter{})
+	cancel()
+
+	if _, err := conn.Write([]byte("x")); err == nil {
+		t.Fatal("expected the write refused by the limiter")
+	}
+}
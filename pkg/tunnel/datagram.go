@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+)
+
+// MaxDatagramSize the largest datagram carried by a UDP tunnel
+const MaxDatagramSize = 65535
+
+// ErrDatagramTooLarge the datagram exceeds MaxDatagramSize
+var ErrDatagramTooLarge = errors.New("datagram too large")
+
+// DatagramConn carries the datagrams of a UDP tunnel over its byte stream.
+// Every datagram is prefixed by its length as 2 bytes in big endian, so the
+// boundaries survive however PUSH splits or merges the bytes.
+type DatagramConn struct {
+	conn io.ReadWriteCloser
+	r    *bufio.Reader
+
+	mu  sync.Mutex
+	buf []byte
+}
+
+// ReadDatagram reads the next datagram into b, the bytes which do not fit
+// in b are discarded like for a UDP socket
+func (c *DatagramConn) ReadDatagram(b []byte) (int, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		return 0, err
+	}
+
+	size := int(binary.BigEndian.Uint16(header[:]))
+
+	n := size
+	if n > len(b) {
+		n = len(b)
+	}
+
+	if _, err := io.ReadFull(c.r, b[:n]); err != nil {
+		return 0, unexpectedEOF(err)
+	}
+
+	if _, err := c.r.Discard(size - n); err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	return n, nil
+}
+
+// WriteDatagram writes b as one datagram
+func (c *DatagramConn) WriteDatagram(b []byte) error {
+	if len(b) > MaxDatagramSize {
+		return ErrDatagramTooLarge
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.buf = append(c.buf[:0], 0, 0)
+	binary.BigEndian.PutUint16(c.buf, uint16(len(b)))
+	c.buf = append(c.buf, b...)
+
+	_, err := c.conn.Write(c.buf)
+	return err
+}
+
+// Close closes the underlying tunnel
+func (c *DatagramConn) Close() error {
+	return c.conn.Close()
+}
+
+// unexpectedEOF reports a datagram cut by the end of the stream
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// NewDatagramConn create DatagramConn on top of the tunnel conn
+func NewDatagramConn(conn io.ReadWriteCloser) *DatagramConn {
+	return &DatagramConn{conn: conn, r: bufio.NewReader(conn)}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"testing"
+)
+
+func TestDatagramConn(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	// the PUSH payloads of 4 bytes split every datagram
+	server := tunnel.NewDatagramConn(tunnel.NewConn(s1, newOptions(), "server"))
+	client := tunnel.NewDatagramConn(tunnel.NewConn(s2, newOptions(), "client"))
+
+	datagrams := [][]byte{[]byte("hello world"), {}, []byte("x"), bytes.Repeat([]byte("y"), 100)}
+
+	for _, d := range datagrams {
+		if err := server.WriteDatagram(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := make([]byte, tunnel.MaxDatagramSize)
+	for _, d := range datagrams {
+		n, err := client.ReadDatagram(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(buf[:n], d) {
+			t.Fatalf("unexpected datagram %q, expected %q", buf[:n], d)
+		}
+	}
+
+	// a datagram larger than the buffer is truncated, the next one is intact
+	if err := server.WriteDatagram([]byte("truncated")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := server.WriteDatagram([]byte("next")); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := client.ReadDatagram(buf[:5])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(buf[:n]) != "trunc" {
+		t.Fatalf("unexpected datagram %q", buf[:n])
+	}
+
+	if n, err = client.ReadDatagram(buf); err != nil || string(buf[:n]) != "next" {
+		t.Fatalf("unexpected datagram %q, got: %v", buf[:n], err)
+	}
+
+	if err := server.WriteDatagram(make([]byte, tunnel.MaxDatagramSize+1)); !errors.Is(err, tunnel.ErrDatagramTooLarge) {
+		t.Fatalf("expected ErrDatagramTooLarge, got %v", err)
+	}
+
+	_ = server.Close()
+
+	if _, err := client.ReadDatagram(buf); err == nil {
+		t.Fatalf("expected the tunnel reset, got %v", err)
+	}
+}
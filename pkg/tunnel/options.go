@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"fmt"
+	"github.com/aapelismith/kun/pkg/types"
+	"github.com/spf13/pflag"
+	"math"
+	"time"
+)
+
+// InitialWindowSize the number of payload bytes either side of a tunnel may
+// push before the peer sends its first WINDOW
+const InitialWindowSize = 64 * 1024
+
+// Options tunnel protocol related configuration
+type Options struct {
+	// KeepaliveInterval a PING is sent when nothing was received from the
+	// peer for this long
+	KeepaliveInterval types.Duration `yaml:"keepalive_interval,omitempty" json:"keepalive_interval,omitempty"`
+
+	// KeepaliveTimeout the tunnel is reset when nothing was received from
+	// the peer for KeepaliveInterval plus this long
+	KeepaliveTimeout types.Duration `yaml:"keepalive_timeout,omitempty" json:"keepalive_timeout,omitempty"`
+
+	// MaxPayloadSize the maximum number of bytes carried by one PUSH
+	MaxPayloadSize int `yaml:"max_payload_size,omitempty" json:"max_payload_size,omitempty"`
+
+	// MaxStreams the maximum number of concurrent logical streams of a
+	// multiplexed tunnel opened by each side
+	MaxStreams int `yaml:"max_streams,omitempty" json:"max_streams,omitempty"`
+
+	// WindowSize the maximum number of received payload bytes of a tunnel
+	// or of a logical stream waiting to be read, the peer is blocked past it
+	WindowSize int `yaml:"window_size,omitempty" json:"window_size,omitempty"`
+
+	// MaxWindowSize the largest window the upstreams may request for their
+	// tunnels instead of WindowSize, the server bounds the requests by it
+	MaxWindowSize int `yaml:"max_window_size,omitempty" json:"max_window_size,omitempty"`
+}
+
+// SetDefaults sets the default values.
+func (o *Options) SetDefaults() {
+	o.KeepaliveInterval = types.Duration(time.Second * 30)
+	o.KeepaliveTimeout = types.Duration(time.Second * 10)
+	o.MaxPayloadSize = 32 * 1024
+	o.MaxStreams = 1024
+	o.WindowSize = 256 * 1024
+	o.MaxWindowSize = 4 * 1024 * 1024
+}
+
+// AddFlags add tunnel related command line parameters
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.Var(&o.KeepaliveInterval, "tunnel.keepalive-interval", "A PING is sent when nothing was "+
+		"received from the peer of a tunnel for this long")
+
+	fs.Var(&o.KeepaliveTimeout, "tunnel.keepalive-timeout", "The tunnel is reset when nothing was "+
+		"received from the peer for keepalive-interval plus this long")
+
+	fs.IntVar(&o.MaxPayloadSize, "tunnel.max-payload-size", o.MaxPayloadSize, "The maximum number "+
+		"of bytes carried by one PUSH")
+
+	fs.IntVar(&o.MaxStreams, "tunnel.max-streams", o.MaxStreams, "The maximum number of concurrent "+
+		"logical streams of a multiplexed tunnel opened by each side")
+
+	fs.IntVar(&o.WindowSize, "tunnel.window-size", o.WindowSize, "The maximum number of received "+
+		"payload bytes of a tunnel or of a logical stream waiting to be read, the peer is blocked past it")
+
+	fs.IntVar(&o.MaxWindowSize, "tunnel.max-window-size", o.MaxWindowSize, "The largest window the "+
+		"upstreams may request for their tunnels instead of window-size")
+}
+
+// Validate verify the configuration and return an error if correct
+func (o *Options) Validate() error {
+	if o.KeepaliveInterval <= 0 {
+		return fmt.Errorf("keepalive_interval must be greater than 0")
+	}
+
+	if o.KeepaliveTimeout <= 0 {
+		return fmt.Errorf("keepalive_timeout must be greater than 0")
+	}
+
+	if o.MaxPayloadSize <= 0 {
+		return fmt.Errorf("max_payload_size must be greater than 0")
+	}
+
+	if o.MaxStreams <= 0 {
+		return fmt.Errorf("max_streams must be greater than 0")
+	}
+
+	if o.WindowSize < InitialWindowSize || o.WindowSize > math.MaxInt32 {
+		return fmt.Errorf("window_size must be between %d and %d", InitialWindowSize, math.MaxInt32)
+	}
+
+	if o.MaxWindowSize < o.WindowSize || o.MaxWindowSize > math.MaxInt32 {
+		return fmt.Errorf("max_window_size must be between window_size and %d", math.MaxInt32)
+	}
+	return nil
+}
+
+// NegotiateWindowSize returns the window of the tunnels of an upstream which
+// requested size, WindowSize if 0, bounded by InitialWindowSize and
+// MaxWindowSize otherwise
+func (o *Options) NegotiateWindowSize(size int) int {
+	switch {
+	case size == 0:
+		return o.WindowSize
+	case size < InitialWindowSize:
+		return InitialWindowSize
+	case size > o.MaxWindowSize:
+		return o.MaxWindowSize
+	}
+	return size
+}
+
+// WithWindowSize returns a copy of o with the window size of an upstream,
+// o itself if size is 0
+func (o *Options) WithWindowSize(size int) *Options {
+	if size == 0 {
+		return o
+	}
+
+	opts := *o
+	opts.WindowSize = size
+	return &opts
+}
+
+// NewOptions create `zero` tunnel options
+func NewOptions() *Options {
+	return new(Options)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"io"
+	"net"
+)
+
+// closeWriter is implemented by the connections which can be half-closed
+type closeWriter interface {
+	CloseWrite() error
+}
+
+// Pipe copies the bytes between a and b in both directions until both
+// directions are finished, then closes a and b
+func Pipe(a, b net.Conn) {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		halfCopy(a, b)
+	}()
+
+	halfCopy(b, a)
+	<-done
+
+	_ = a.Close()
+	_ = b.Close()
+}
+
+// halfCopy copies src to dst and half-closes dst once src is finished,
+// both are closed when the copy fails
+func halfCopy(dst, src net.Conn) {
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		_ = src.Close()
+		return
+	}
+
+	if c, ok := dst.(closeWriter); ok {
+		_ = c.CloseWrite()
+		return
+	}
+	_ = dst.Close()
+}
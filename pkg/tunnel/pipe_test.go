@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel_test
+
+import (
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"io"
+	"net"
+	"testing"
+)
+
+// tcpPair returns both ends of a loopback tcp connection
+func tcpPair(t *testing.T) (*net.TCPConn, *net.TCPConn) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, _ := ln.Accept()
+		accepted <- conn
+	}()
+
+	dialed, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dialed.(*net.TCPConn), (<-accepted).(*net.TCPConn)
+}
+
+func TestPipe(t *testing.T) {
+	client, a := tcpPair(t)
+	b, server := tcpPair(t)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tunnel.Pipe(a, b)
+	}()
+
+	if _, err := client.Write([]byte("request")); err != nil {
+		t.Fatal(err)
+	}
+
+	// the half-close of the client reaches the server, which still answers
+	if err := client.CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+
+	request, err := io.ReadAll(server)
+	if err != nil || string(request) != "request" {
+		t.Fatalf("unexpected request %q, got: %v", request, err)
+	}
+
+	if _, err := server.Write([]byte("response")); err != nil {
+		t.Fatal(err)
+	}
+	_ = server.Close()
+
+	response, err := io.ReadAll(client)
+	if err != nil || string(response) != "response" {
+		t.Fatalf("unexpected response %q, got: %v", response, err)
+	}
+
+	<-done
+	_ = client.Close()
+}
@@ -0,0 +1,480 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"io"
+	"math"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrTooManyStreams the session carries MaxStreams streams opened by this side already
+var ErrTooManyStreams = errors.New("too many tunnel streams")
+
+// acceptBacklog the number of streams opened by the peer waiting for Accept,
+// the streams beyond are reset
+const acceptBacklog = 16
+
+// Session multiplexes logical streams over one ConnectTunnel stream. Every
+// message carries the id of its stream, and every stream follows the state
+// machine of a Conn on its own: its first PUSH opens it, FINISH half-closes
+// it and RESET aborts it. The server side opens the streams with odd ids and
+// the client side the ones with even ids. The stream 0 is the session itself,
+// it carries the PING and PONG probing the peer on behalf of all the streams
+// and the RESET aborting all of them.
+type Session struct {
+	stream Stream
+	opts   *Options
+	addr   string
+	server bool
+
+	// sendMu serializes the calls of stream.Send
+	sendMu sync.Mutex
+
+	mu         sync.Mutex
+	streams    map[uint32]*muxStream
+	nextID     uint32
+	peerID     uint32
+	opened     int
+	accepted   int
+	err        error
+	terminated bool
+	codec      Codec
+	limiter    Limiter
+
+	accept chan *Conn
+	done   chan struct{}
+	pong   chan struct{}
+
+	// lastRecv the unix nano time of the last received message
+	lastRecv int64
+	// stalled is 1 while a received message waits for its stream
+	stalled int32
+	// probing is 1 while a PING or PONG of the keepalive is being sent
+	probing int32
+}
+
+// Done is closed when the session is closed or reset
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason why the session terminated, nil if it is alive
+func (s *Session) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
+// NumStreams the number of streams currently carried by the session
+func (s *Session) NumStreams() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.opened + s.accepted
+}
+
+// SetCodec compresses the payload pushed on the streams created from now on
+// with codec where it helps, see Conn.SetCodec
+func (s *Session) SetCodec(codec Codec) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.codec = codec
+}
+
+// SetLimiter throttles the bytes of the streams created from now on with
+// limiter, which is shared by all of them, see Conn.SetLimiter
+func (s *Session) SetLimiter(limiter Limiter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.limiter = limiter
+}
+
+// Open creates a stream, which is opened on the peer by its first PUSH. The
+// id of the stream is allocated when its first message is sent, so that the
+// peer sees the ids of the new streams increasing.
+func (s *Session) Open() (*Conn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.terminated {
+		return nil, s.closedErr()
+	}
+
+	if s.opened >= s.opts.MaxStreams {
+		return nil, ErrTooManyStreams
+	}
+
+	s.opened++
+
+	return s.newStreamLocked(0, true), nil
+}
+
+// Accept waits for the next stream opened by the peer
+func (s *Session) Accept() (*Conn, error) {
+	select {
+	case c := <-s.accept:
+		return c, nil
+	case <-s.done:
+		return nil, s.Err()
+	}
+}
+
+// Close aborts the session and all of its streams with RESET
+func (s *Session) Close() error {
+	s.terminate(net.ErrClosed, true)
+	return nil
+}
+
+// closedErr the error returned once the session terminated, must be called with s.mu held
+func (s *Session) closedErr() error {
+	if s.err != nil {
+		return s.err
+	}
+	return ErrClosed
+}
+
+// newStreamLocked creates the Conn of the stream id, 0 for a local stream
+// not sent yet, must be called with s.mu held
+func (s *Session) newStreamLocked(id uint32, local bool) *Conn {
+	ms := &muxStream{
+		session: s,
+		id:      id,
+		local:   local,
+		recv:    make(chan *v1.TunnelMessage, 16),
+		closed:  make(chan struct{}),
+	}
+
+	if id != 0 {
+		s.streams[id] = ms
+	}
+
+	c := newConn(ms, s.opts, s.addr, false)
+	c.SetCodec(s.codec)
+	c.SetLimiter(s.limiter)
+
+	go func() {
+		<-c.Done()
+		s.remove(ms)
+	}()
+	return c
+}
+
+// remove forgets the terminated stream ms
+func (s *Session) remove(ms *muxStream) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ms.id != 0 && s.streams[ms.id] == ms {
+		delete(s.streams, ms.id)
+	}
+
+	if ms.local {
+		s.opened--
+	} else {
+		s.accepted--
+	}
+	close(ms.closed)
+}
+
+// isPeerID reports whether id is opened by the peer
+func (s *Session) isPeerID(id uint32) bool {
+	return (id%2 == 0) == s.server
+}
+
+// send sends msg on the stream ms, or on the stream 0 if ms is nil,
+// unless the session terminated
+func (s *Session) send(ms *muxStream, msg *v1.TunnelMessage) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	s.mu.Lock()
+	if s.terminated {
+		defer s.mu.Unlock()
+		return s.closedErr()
+	}
+
+	if ms != nil && ms.id == 0 {
+		if msg.Command != CommandPush {
+			// the peer never heard of the stream
+			s.mu.Unlock()
+			return nil
+		}
+
+		if s.nextID > math.MaxUint32-2 {
+			s.mu.Unlock()
+			return ErrTooManyStreams
+		}
+
+		ms.id = s.nextID
+		s.nextID += 2
+		s.streams[ms.id] = ms
+	}
+	s.mu.Unlock()
+
+	if ms != nil {
+		msg.StreamId = ms.id
+	}
+
+	if err := s.stream.Send(msg); err != nil {
+		s.terminate(fmt.Errorf("%w: %v", ErrReset, err), false)
+		return err
+	}
+	return nil
+}
+
+// route returns the stream of msg, creating the stream opened by the peer
+// with msg. A nil stream is returned for the messages of the streams which
+// are terminated or refused.
+func (s *Session) route(msg *v1.TunnelMessage) (*muxStream, *Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ms, ok := s.streams[msg.StreamId]; ok {
+		return ms, nil
+	}
+
+	// the messages of the terminated streams are dropped
+	if !s.isPeerID(msg.StreamId) || msg.StreamId <= s.peerID || msg.Command != CommandPush {
+		return nil, nil
+	}
+
+	s.peerID = msg.StreamId
+
+	if s.accepted >= s.opts.MaxStreams {
+		go func(id uint32) {
+			_ = s.send(nil, &v1.TunnelMessage{Command: CommandReset, StreamId: id})
+		}(msg.StreamId)
+		return nil, nil
+	}
+
+	s.accepted++
+
+	c := s.newStreamLocked(msg.StreamId, false)
+	return s.streams[msg.StreamId], c
+}
+
+// recvLoop receives the messages of the peer and hands them to their
+// streams until the session terminates
+func (s *Session) recvLoop() {
+	for {
+		msg, err := s.stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				err = fmt.Errorf("%w: %v", ErrReset, err)
+			} else {
+				err = ErrReset
+			}
+			s.terminate(err, false)
+			return
+		}
+
+		atomic.StoreInt64(&s.lastRecv, time.Now().UnixNano())
+
+		if msg.StreamId == 0 {
+			switch msg.Command {
+			case CommandPing:
+				select {
+				case s.pong <- struct{}{}:
+				default:
+				}
+			case CommandPong:
+			case CommandReset:
+				s.terminate(ErrReset, false)
+				return
+			default:
+				s.terminate(fmt.Errorf("%w: %s on stream 0", ErrProtocol, msg.Command), true)
+				return
+			}
+			continue
+		}
+
+		ms, c := s.route(msg)
+		if ms == nil {
+			continue
+		}
+
+		atomic.StoreInt32(&s.stalled, 1)
+		select {
+		case ms.recv <- msg:
+		case <-ms.closed:
+		case <-s.done:
+			return
+		}
+		atomic.StoreInt32(&s.stalled, 0)
+		atomic.StoreInt64(&s.lastRecv, time.Now().UnixNano())
+
+		if c != nil {
+			select {
+			case s.accept <- c:
+			default:
+				_ = c.Close()
+			}
+		}
+	}
+}
+
+// keepaliveLoop answers PING, probes the silent peer and resets the session
+// when the peer stays silent past the keepalive timeout. A peer is not
+// considered silent while its message waits for a stream.
+func (s *Session) keepaliveLoop() {
+	interval := time.Duration(s.opts.KeepaliveInterval)
+	timeout := time.Duration(s.opts.KeepaliveTimeout)
+
+	tick := interval
+	if timeout < tick {
+		tick = timeout
+	}
+
+	ticker := time.NewTicker(tick / 2)
+	defer ticker.Stop()
+
+	var lastPing time.Time
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-s.pong:
+			s.probe(CommandPong)
+		case now := <-ticker.C:
+			silent := now.Sub(time.Unix(0, atomic.LoadInt64(&s.lastRecv)))
+
+			if silent >= interval+timeout && atomic.LoadInt32(&s.stalled) == 0 {
+				s.terminate(ErrTimeout, true)
+				return
+			}
+
+			if silent >= interval && now.Sub(lastPing) >= interval {
+				s.probe(CommandPing)
+				lastPing = now
+			}
+		}
+	}
+}
+
+// probe sends the PING or PONG command on the stream 0 in the background,
+// see Conn.probe
+func (s *Session) probe(command string) {
+	if !atomic.CompareAndSwapInt32(&s.probing, 0, 1) {
+		return
+	}
+
+	go func() {
+		defer atomic.StoreInt32(&s.probing, 0)
+		_ = s.send(nil, &v1.TunnelMessage{Command: command})
+	}()
+}
+
+// terminate ends the session with err at once, sending RESET on the stream
+// 0 to the peer in the background if reset is true, see Conn.terminate
+func (s *Session) terminate(err error, reset bool) {
+	s.mu.Lock()
+	if s.terminated {
+		s.mu.Unlock()
+		return
+	}
+
+	s.terminated = true
+	s.err = err
+	s.mu.Unlock()
+
+	close(s.done)
+
+	if reset {
+		go func() {
+			s.sendMu.Lock()
+			defer s.sendMu.Unlock()
+
+			_ = s.stream.Send(&v1.TunnelMessage{Command: CommandReset})
+		}()
+	}
+}
+
+// NewServerSession create Session on the server side of stream and start
+// serving it, the stream should be canceled by its owner once the Session is done
+func NewServerSession(stream Stream, opts *Options, addr string) *Session {
+	return newSession(stream, opts, addr, true)
+}
+
+// NewClientSession create Session on the client side of stream and start
+// serving it, the stream should be canceled by its owner once the Session is done
+func NewClientSession(stream Stream, opts *Options, addr string) *Session {
+	return newSession(stream, opts, addr, false)
+}
+
+// newSession create Session on top of stream, the server side opens the odd stream ids
+func newSession(stream Stream, opts *Options, addr string, server bool) *Session {
+	s := &Session{
+		stream:   stream,
+		opts:     opts,
+		addr:     addr,
+		server:   server,
+		streams:  make(map[uint32]*muxStream),
+		nextID:   2,
+		accept:   make(chan *Conn, acceptBacklog),
+		done:     make(chan struct{}),
+		pong:     make(chan struct{}, 1),
+		lastRecv: time.Now().UnixNano(),
+	}
+
+	if server {
+		s.nextID = 1
+	}
+
+	go s.recvLoop()
+	go s.keepaliveLoop()
+	return s
+}
+
+// muxStream the Stream of a logical stream of a Session
+type muxStream struct {
+	session *Session
+	id      uint32
+	local   bool
+	recv    chan *v1.TunnelMessage
+	closed  chan struct{}
+}
+
+// Context implements Stream
+func (m *muxStream) Context() context.Context {
+	return m.session.stream.Context()
+}
+
+// Send implements Stream
+func (m *muxStream) Send(msg *v1.TunnelMessage) error {
+	return m.session.send(m, msg)
+}
+
+// Recv implements Stream
+func (m *muxStream) Recv() (*v1.TunnelMessage, error) {
+	select {
+	case msg := <-m.recv:
+		return msg, nil
+	case <-m.closed:
+		return nil, io.EOF
+	case <-m.session.done:
+		return nil, io.EOF
+	}
+}
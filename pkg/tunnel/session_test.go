@@ -0,0 +1,256 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel_test
+
+import (
+	"errors"
+	"fmt"
+	v1 "github.com/aapelismith/kun/pkg/apiserver/apis/v1"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"github.com/aapelismith/kun/pkg/types"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSession_Streams(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	server := tunnel.NewServerSession(s1, newOptions(), "server")
+	client := tunnel.NewClientSession(s2, newOptions(), "client")
+
+	// the client echoes every stream
+	go func() {
+		for {
+			conn, err := client.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+				_ = conn.CloseWrite()
+			}()
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		conn, err := server.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			msg := fmt.Sprintf("hello stream %d", i)
+			if _, err := conn.Write([]byte(msg)); err != nil {
+				t.Error(err)
+				return
+			}
+			_ = conn.CloseWrite()
+
+			data, err := io.ReadAll(conn)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			if string(data) != msg {
+				t.Errorf("unexpected data %q", data)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	deadline := time.Now().Add(time.Second)
+	for server.NumStreams() != 0 || client.NumStreams() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the closed streams removed, got %d and %d", server.NumStreams(), client.NumStreams())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSession_ResetStream(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	server := tunnel.NewServerSession(s1, newOptions(), "server")
+	client := tunnel.NewClientSession(s2, newOptions(), "client")
+
+	a, err := server.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := server.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, conn := range []*tunnel.Conn{a, b} {
+		if err := conn.Open(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ca, err := client.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cb, err := client.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// resetting a stream leaves the others alone
+	_ = ca.Close()
+
+	select {
+	case <-a.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the stream was not reset")
+	}
+
+	if !errors.Is(a.Err(), tunnel.ErrReset) {
+		t.Fatalf("expected ErrReset, got %v", a.Err())
+	}
+
+	if _, err := b.Write([]byte("still alive")); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 11)
+	if _, err := io.ReadFull(cb, buf); err != nil || string(buf) != "still alive" {
+		t.Fatalf("unexpected data %q, got: %v", buf, err)
+	}
+
+	// closing the session resets all of its streams
+	_ = server.Close()
+
+	for _, c := range []interface{ Done() <-chan struct{} }{b, cb, client} {
+		select {
+		case <-c.Done():
+		case <-time.After(time.Second):
+			t.Fatal("expected the session and its streams reset")
+		}
+	}
+
+	if _, err := client.Accept(); !errors.Is(err, tunnel.ErrReset) {
+		t.Fatalf("expected ErrReset, got %v", err)
+	}
+}
+
+func TestSession_ProtocolViolation(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	server := tunnel.NewServerSession(s1, newOptions(), "server")
+
+	// the stream 0 is the session itself
+	s2.send <- &v1.TunnelMessage{Command: tunnel.CommandPush, Payload: []byte("data")}
+
+	select {
+	case <-server.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the session was not reset")
+	}
+
+	if err := server.Err(); !errors.Is(err, tunnel.ErrProtocol) {
+		t.Fatalf("expected ErrProtocol, got %v", err)
+	}
+
+	select {
+	case msg := <-s2.recv:
+		if msg.Command != tunnel.CommandReset || msg.StreamId != 0 {
+			t.Fatalf("expected RESET of the session, got %s on %d", msg.Command, msg.StreamId)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("RESET was not sent")
+	}
+}
+
+func TestSession_MaxStreams(t *testing.T) {
+	s1, s2 := newStreamPair(t)
+
+	opts := newOptions()
+	opts.MaxStreams = 1
+
+	server := tunnel.NewServerSession(s1, opts, "server")
+	tunnel.NewClientSession(s2, opts, "client")
+
+	conn, err := server.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := server.Open(); !errors.Is(err, tunnel.ErrTooManyStreams) {
+		t.Fatalf("expected ErrTooManyStreams, got %v", err)
+	}
+
+	_ = conn.Close()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, err := server.Open(); err == nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the stream released when closed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSession_StuckPeer(t *testing.T) {
+	opts := newOptions()
+	opts.KeepaliveInterval = types.Duration(time.Millisecond * 20)
+	opts.KeepaliveTimeout = types.Duration(time.Millisecond * 40)
+
+	session := tunnel.NewServerSession(newStuckStream(t), opts, "server")
+
+	conn, err := session.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		_, _ = conn.Write([]byte("ping"))
+	}()
+
+	select {
+	case <-session.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the session of the stuck peer was not reset")
+	}
+
+	if err := session.Err(); !errors.Is(err, tunnel.ErrTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		_ = session.Close()
+		_ = conn.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("Close blocked on the stuck peer")
+	}
+}
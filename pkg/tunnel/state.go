@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Commands used in tunnel communication
+const (
+	// CommandPing asks the peer to answer with CommandPong, either side may
+	// send it at any time before the tunnel is closed
+	CommandPing = "PING"
+
+	// CommandPong answers CommandPing
+	CommandPong = "PONG"
+
+	// CommandPush carries payload bytes. The first PUSH of a tunnel, which
+	// may be empty, opens it: the client connects its local service then.
+	// The bytes of a UDP tunnel are datagrams framed by DatagramConn.
+	CommandPush = "PUSH"
+
+	// CommandFinish half-closes the tunnel, the sender will push no more bytes
+	CommandFinish = "FINISH"
+
+	// CommandReset aborts the tunnel in both directions
+	CommandReset = "RESET"
+
+	// CommandWindow allows the peer to push the number of payload bytes of
+	// its window field more. Either side starts allowed to push
+	// InitialWindowSize bytes, pushing more than allowed is a protocol
+	// violation. Like PING it is legal at any time before the tunnel is closed.
+	CommandWindow = "WINDOW"
+)
+
+var (
+	// ErrProtocol the peer sent a message which is illegal in the current state
+	ErrProtocol = errors.New("tunnel protocol violation")
+
+	// ErrFinished the tunnel is half-closed, no more bytes may be pushed
+	ErrFinished = errors.New("tunnel finished")
+
+	// ErrReset the tunnel was aborted
+	ErrReset = errors.New("tunnel reset")
+
+	// ErrClosed the tunnel is closed
+	ErrClosed = errors.New("tunnel closed")
+)
+
+// Direction the direction of a message
+type Direction int
+
+const (
+	// Inbound a message received from the peer
+	Inbound Direction = iota
+	// Outbound a message sent to the peer
+	Outbound
+)
+
+// State the state of a tunnel
+//
+//	           PUSH                FINISH (sent)
+//	Idle ───────────────► Open ─────────────────► LocalFinished ──┐
+//	 │                     │                                      │ FINISH (received)
+//	 │                     │ FINISH (received)                    ▼
+//	 │                     └─────────────────► RemoteFinished ──► Closed
+//	 │                                              FINISH (sent)
+//	 └─ RESET from any state except Closed ───────────────────► Reset
+//
+// PING, PONG and WINDOW are legal in every state but Closed and Reset and
+// do not change it. Receiving a message which is illegal in the current state is
+// a protocol violation and resets the tunnel.
+type State int
+
+const (
+	// StateIdle the tunnel is connected but no byte was pushed yet
+	StateIdle State = iota
+	// StateOpen both sides may push bytes
+	StateOpen
+	// StateLocalFinished we sent FINISH, the peer may still push bytes
+	StateLocalFinished
+	// StateRemoteFinished the peer sent FINISH, we may still push bytes
+	StateRemoteFinished
+	// StateClosed both sides sent FINISH
+	StateClosed
+	// StateReset the tunnel was aborted
+	StateReset
+)
+
+var stateNames = map[State]string{
+	StateIdle:           "IDLE",
+	StateOpen:           "OPEN",
+	StateLocalFinished:  "LOCAL_FINISHED",
+	StateRemoteFinished: "REMOTE_FINISHED",
+	StateClosed:         "CLOSED",
+	StateReset:          "RESET",
+}
+
+// String implements fmt.Stringer
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// Terminated reports whether no more message may be exchanged
+func (s State) Terminated() bool {
+	return s == StateClosed || s == StateReset
+}
+
+// CanPush reports whether bytes may be pushed in direction
+func (s State) CanPush(dir Direction) bool {
+	switch s {
+	case StateIdle, StateOpen:
+		return true
+	case StateLocalFinished:
+		return dir == Inbound
+	case StateRemoteFinished:
+		return dir == Outbound
+	default:
+		return false
+	}
+}
+
+// Next returns the state after a message with command is sent or received
+func (s State) Next(dir Direction, command string) (State, error) {
+	switch command {
+	case CommandReset:
+		if s == StateClosed {
+			return s, s.illegal(dir, command)
+		}
+		return StateReset, nil
+	case CommandPing, CommandPong, CommandWindow:
+		if s.Terminated() {
+			return s, s.illegal(dir, command)
+		}
+		return s, nil
+	case CommandPush:
+		if !s.CanPush(dir) {
+			return s, s.illegal(dir, command)
+		}
+		if s == StateIdle {
+			return StateOpen, nil
+		}
+		return s, nil
+	case CommandFinish:
+		switch {
+		case (s == StateIdle || s == StateOpen) && dir == Outbound:
+			return StateLocalFinished, nil
+		case (s == StateIdle || s == StateOpen) && dir == Inbound:
+			return StateRemoteFinished, nil
+		case s == StateLocalFinished && dir == Inbound, s == StateRemoteFinished && dir == Outbound:
+			return StateClosed, nil
+		}
+		return s, s.illegal(dir, command)
+	default:
+		return s, s.illegal(dir, command)
+	}
+}
+
+// illegal returns the error of a message which may not be exchanged in s
+func (s State) illegal(dir Direction, command string) error {
+	if dir == Outbound {
+		switch {
+		case s == StateReset:
+			return ErrReset
+		case s == StateClosed:
+			return ErrClosed
+		case command == CommandPush || command == CommandFinish:
+			return ErrFinished
+		}
+	}
+	return fmt.Errorf("%w: unexpected %q in state %s", ErrProtocol, command, s)
+}
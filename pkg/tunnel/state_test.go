@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The KunStack Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel_test
+
+import (
+	"errors"
+	"github.com/aapelismith/kun/pkg/tunnel"
+	"testing"
+)
+
+func TestState_Next(t *testing.T) {
+	tests := []struct {
+		state   tunnel.State
+		dir     tunnel.Direction
+		command string
+		next    tunnel.State
+		err     error
+	}{
+		{tunnel.StateIdle, tunnel.Inbound, tunnel.CommandPush, tunnel.StateOpen, nil},
+		{tunnel.StateIdle, tunnel.Outbound, tunnel.CommandPush, tunnel.StateOpen, nil},
+		{tunnel.StateIdle, tunnel.Inbound, tunnel.CommandPing, tunnel.StateIdle, nil},
+		{tunnel.StateOpen, tunnel.Outbound, tunnel.CommandPong, tunnel.StateOpen, nil},
+		{tunnel.StateRemoteFinished, tunnel.Inbound, tunnel.CommandWindow, tunnel.StateRemoteFinished, nil},
+		{tunnel.StateClosed, tunnel.Inbound, tunnel.CommandWindow, tunnel.StateClosed, tunnel.ErrProtocol},
+		{tunnel.StateOpen, tunnel.Outbound, tunnel.CommandFinish, tunnel.StateLocalFinished, nil},
+		{tunnel.StateOpen, tunnel.Inbound, tunnel.CommandFinish, tunnel.StateRemoteFinished, nil},
+		{tunnel.StateLocalFinished, tunnel.Inbound, tunnel.CommandPush, tunnel.StateLocalFinished, nil},
+		{tunnel.StateLocalFinished, tunnel.Outbound, tunnel.CommandPush, tunnel.StateLocalFinished, tunnel.ErrFinished},
+		{tunnel.StateLocalFinished, tunnel.Inbound, tunnel.CommandFinish, tunnel.StateClosed, nil},
+		{tunnel.StateRemoteFinished, tunnel.Inbound, tunnel.CommandPush, tunnel.StateRemoteFinished, tunnel.ErrProtocol},
+		{tunnel.StateRemoteFinished, tunnel.Inbound, tunnel.CommandFinish, tunnel.StateRemoteFinished, tunnel.ErrProtocol},
+		{tunnel.StateRemoteFinished, tunnel.Outbound, tunnel.CommandFinish, tunnel.StateClosed, nil},
+		{tunnel.StateOpen, tunnel.Inbound, tunnel.CommandReset, tunnel.StateReset, nil},
+		{tunnel.StateClosed, tunnel.Inbound, tunnel.CommandPing, tunnel.StateClosed, tunnel.ErrProtocol},
+		{tunnel.StateClosed, tunnel.Outbound, tunnel.CommandReset, tunnel.StateClosed, tunnel.ErrClosed},
+		{tunnel.StateReset, tunnel.Outbound, tunnel.CommandPush, tunnel.StateReset, tunnel.ErrReset},
+		{tunnel.StateOpen, tunnel.Inbound, "HELLO", tunnel.StateOpen, tunnel.ErrProtocol},
+	}
+
+	for _, tt := range tests {
+		next, err := tt.state.Next(tt.dir, tt.command)
+		if next != tt.next || !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("%s %s in %s: expected %s and %v, got %s and %v",
+				tt.command, direction(tt.dir), tt.state, tt.next, tt.err, next, err)
+		}
+	}
+}
+
+func direction(dir tunnel.Direction) string {
+	if dir == tunnel.Inbound {
+		return "received"
+	}
+	return "sent"
+}